@@ -0,0 +1,61 @@
+package azurerm
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+)
+
+func findApplicationGatewayBackendAddressPoolByName(gateway *network.ApplicationGateway, name string) (*network.ApplicationGatewayBackendAddressPool, int, bool) {
+	if gateway == nil || gateway.ApplicationGatewayPropertiesFormat == nil || gateway.ApplicationGatewayPropertiesFormat.BackendAddressPools == nil {
+		return nil, -1, false
+	}
+
+	for i, pool := range *gateway.ApplicationGatewayPropertiesFormat.BackendAddressPools {
+		if pool.Name != nil && *pool.Name == name {
+			return &pool, i, true
+		}
+	}
+
+	return nil, -1, false
+}
+
+func findApplicationGatewayHTTPListenerByName(gateway *network.ApplicationGateway, name string) (*network.ApplicationGatewayHTTPListener, int, bool) {
+	if gateway == nil || gateway.ApplicationGatewayPropertiesFormat == nil || gateway.ApplicationGatewayPropertiesFormat.HTTPListeners == nil {
+		return nil, -1, false
+	}
+
+	for i, listener := range *gateway.ApplicationGatewayPropertiesFormat.HTTPListeners {
+		if listener.Name != nil && *listener.Name == name {
+			return &listener, i, true
+		}
+	}
+
+	return nil, -1, false
+}
+
+func findApplicationGatewayProbeByName(gateway *network.ApplicationGateway, name string) (*network.ApplicationGatewayProbe, int, bool) {
+	if gateway == nil || gateway.ApplicationGatewayPropertiesFormat == nil || gateway.ApplicationGatewayPropertiesFormat.Probes == nil {
+		return nil, -1, false
+	}
+
+	for i, probe := range *gateway.ApplicationGatewayPropertiesFormat.Probes {
+		if probe.Name != nil && *probe.Name == name {
+			return &probe, i, true
+		}
+	}
+
+	return nil, -1, false
+}
+
+func findApplicationGatewayRequestRoutingRuleByName(gateway *network.ApplicationGateway, name string) (*network.ApplicationGatewayRequestRoutingRule, int, bool) {
+	if gateway == nil || gateway.ApplicationGatewayPropertiesFormat == nil || gateway.ApplicationGatewayPropertiesFormat.RequestRoutingRules == nil {
+		return nil, -1, false
+	}
+
+	for i, rule := range *gateway.ApplicationGatewayPropertiesFormat.RequestRoutingRules {
+		if rule.Name != nil && *rule.Name == name {
+			return &rule, i, true
+		}
+	}
+
+	return nil, -1, false
+}
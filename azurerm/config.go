@@ -49,6 +49,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/2017-08-01-preview/security"
 	"github.com/Azure/azure-sdk-for-go/services/preview/signalr/mgmt/2018-03-01-preview/signalr"
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	sqlPreview "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-03-01-preview/sql"
 	MsSql "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2016-06-01/recoveryservices"
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
@@ -63,6 +64,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
 	"github.com/Azure/azure-sdk-for-go/services/servicefabric/mgmt/2018-02-01/servicefabric"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
+	storagePolicies "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2018-07-01/storage"
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2017-05-01/trafficmanager"
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 
@@ -90,6 +92,9 @@ type ArmClient struct {
 
 	StopContext context.Context
 
+	// features contains the behaviours configured in the `features` block
+	features features
+
 	cosmosDBClient documentdb.DatabaseAccountsClient
 
 	automationAccountClient               automation.AccountClient
@@ -150,19 +155,21 @@ type ArmClient struct {
 	cognitiveAccountsClient cognitiveservices.AccountsClient
 
 	// Compute
-	availSetClient             compute.AvailabilitySetsClient
-	diskClient                 compute.DisksClient
-	imageClient                compute.ImagesClient
-	galleriesClient            compute.GalleriesClient
-	galleryImagesClient        compute.GalleryImagesClient
-	galleryImageVersionsClient compute.GalleryImageVersionsClient
-	snapshotsClient            compute.SnapshotsClient
-	usageOpsClient             compute.UsageClient
-	vmExtensionImageClient     compute.VirtualMachineExtensionImagesClient
-	vmExtensionClient          compute.VirtualMachineExtensionsClient
-	vmScaleSetClient           compute.VirtualMachineScaleSetsClient
-	vmImageClient              compute.VirtualMachineImagesClient
-	vmClient                   compute.VirtualMachinesClient
+	availSetClient                  compute.AvailabilitySetsClient
+	diskClient                      compute.DisksClient
+	imageClient                     compute.ImagesClient
+	galleriesClient                 compute.GalleriesClient
+	galleryImagesClient             compute.GalleryImagesClient
+	galleryImageVersionsClient      compute.GalleryImageVersionsClient
+	snapshotsClient                 compute.SnapshotsClient
+	usageOpsClient                  compute.UsageClient
+	vmExtensionImageClient          compute.VirtualMachineExtensionImagesClient
+	vmExtensionClient               compute.VirtualMachineExtensionsClient
+	vmScaleSetClient                compute.VirtualMachineScaleSetsClient
+	vmScaleSetRollingUpgradesClient compute.VirtualMachineScaleSetRollingUpgradesClient
+	vmScaleSetVMsClient             compute.VirtualMachineScaleSetVMsClient
+	vmImageClient                   compute.VirtualMachineImagesClient
+	vmClient                        compute.VirtualMachinesClient
 
 	// Devices
 	iothubResourceClient devices.IotHubResourceClient
@@ -177,27 +184,34 @@ type ArmClient struct {
 	devSpaceControllerClient devspaces.ControllersClient
 
 	// Databases
-	mariadbDatabasesClient                   mariadb.DatabasesClient
-	mariadbServersClient                     mariadb.ServersClient
-	mysqlConfigurationsClient                mysql.ConfigurationsClient
-	mysqlDatabasesClient                     mysql.DatabasesClient
-	mysqlFirewallRulesClient                 mysql.FirewallRulesClient
-	mysqlServersClient                       mysql.ServersClient
-	mysqlVirtualNetworkRulesClient           mysql.VirtualNetworkRulesClient
-	postgresqlConfigurationsClient           postgresql.ConfigurationsClient
-	postgresqlDatabasesClient                postgresql.DatabasesClient
-	postgresqlFirewallRulesClient            postgresql.FirewallRulesClient
-	postgresqlServersClient                  postgresql.ServersClient
-	postgresqlVirtualNetworkRulesClient      postgresql.VirtualNetworkRulesClient
-	sqlDatabasesClient                       sql.DatabasesClient
-	sqlDatabaseThreatDetectionPoliciesClient sql.DatabaseThreatDetectionPoliciesClient
-	sqlElasticPoolsClient                    sql.ElasticPoolsClient
+	mariadbDatabasesClient                      mariadb.DatabasesClient
+	mariadbServersClient                        mariadb.ServersClient
+	mysqlConfigurationsClient                   mysql.ConfigurationsClient
+	mysqlDatabasesClient                        mysql.DatabasesClient
+	mysqlFirewallRulesClient                    mysql.FirewallRulesClient
+	mysqlServersClient                          mysql.ServersClient
+	mysqlVirtualNetworkRulesClient              mysql.VirtualNetworkRulesClient
+	postgresqlConfigurationsClient              postgresql.ConfigurationsClient
+	postgresqlDatabasesClient                   postgresql.DatabasesClient
+	postgresqlFirewallRulesClient               postgresql.FirewallRulesClient
+	postgresqlServersClient                     postgresql.ServersClient
+	postgresqlVirtualNetworkRulesClient         postgresql.VirtualNetworkRulesClient
+	sqlBackupLongTermRetentionPoliciesClient    sql.BackupLongTermRetentionPoliciesClient
+	sqlDatabasesClient                          sql.DatabasesClient
+	sqlDatabaseThreatDetectionPoliciesClient    sql.DatabaseThreatDetectionPoliciesClient
+	sqlDatabaseVulnerabilityAssessmentsClient   sqlPreview.DatabaseVulnerabilityAssessmentsClient
+	sqlEncryptionProtectorsClient               sql.EncryptionProtectorsClient
+	sqlExtendedServerBlobAuditingPoliciesClient sqlPreview.ExtendedServerBlobAuditingPoliciesClient
+	sqlElasticPoolsClient                       sql.ElasticPoolsClient
 	// Client for the new 2017-10-01-preview SQL API which implements vCore, DTU, and Azure data standards
-	msSqlElasticPoolsClient              MsSql.ElasticPoolsClient
-	sqlFirewallRulesClient               sql.FirewallRulesClient
-	sqlServersClient                     sql.ServersClient
-	sqlServerAzureADAdministratorsClient sql.ServerAzureADAdministratorsClient
-	sqlVirtualNetworkRulesClient         sql.VirtualNetworkRulesClient
+	msSqlBackupShortTermRetentionPoliciesClient MsSql.BackupShortTermRetentionPoliciesClient
+	msSqlElasticPoolsClient                     MsSql.ElasticPoolsClient
+	sqlFailoverGroupsClient                     sql.FailoverGroupsClient
+	sqlFirewallRulesClient                      sql.FirewallRulesClient
+	sqlServersClient                            sql.ServersClient
+	sqlServerAzureADAdministratorsClient        sql.ServerAzureADAdministratorsClient
+	sqlServerKeysClient                         sql.ServerKeysClient
+	sqlVirtualNetworkRulesClient                sql.VirtualNetworkRulesClient
 
 	// Data Lake Store
 	dataLakeStoreAccountClient       storeAccount.AccountsClient
@@ -242,6 +256,8 @@ type ArmClient struct {
 	applicationGatewayClient        network.ApplicationGatewaysClient
 	applicationSecurityGroupsClient network.ApplicationSecurityGroupsClient
 	azureFirewallsClient            network.AzureFirewallsClient
+	connectionMonitorsClient        network.ConnectionMonitorsClient
+	ddosProtectionPlanClient        network.DdosProtectionPlansClient
 	expressRouteAuthsClient         network.ExpressRouteCircuitAuthorizationsClient
 	expressRouteCircuitClient       network.ExpressRouteCircuitsClient
 	expressRoutePeeringsClient      network.ExpressRouteCircuitPeeringsClient
@@ -250,6 +266,8 @@ type ArmClient struct {
 	localNetConnClient              network.LocalNetworkGatewaysClient
 	packetCapturesClient            network.PacketCapturesClient
 	publicIPClient                  network.PublicIPAddressesClient
+	publicIPPrefixesClient          network.PublicIPPrefixesClient
+	routeFiltersClient              network.RouteFiltersClient
 	routesClient                    network.RoutesClient
 	routeTablesClient               network.RouteTablesClient
 	secGroupClient                  network.SecurityGroupsClient
@@ -259,6 +277,10 @@ type ArmClient struct {
 	vnetGatewayClient               network.VirtualNetworkGatewaysClient
 	vnetClient                      network.VirtualNetworksClient
 	vnetPeeringsClient              network.VirtualNetworkPeeringsClient
+	virtualHubsClient               network.VirtualHubsClient
+	virtualWansClient               network.VirtualWansClient
+	vpnGatewaysClient               network.VpnGatewaysClient
+	vpnSitesClient                  network.VpnSitesClient
 	watcherClient                   network.WatchersClient
 
 	// Notification Hubs
@@ -309,8 +331,9 @@ type ArmClient struct {
 	signalRClient signalr.Client
 
 	// Storage
-	storageServiceClient storage.AccountsClient
-	storageUsageClient   storage.UsageClient
+	storageServiceClient            storage.AccountsClient
+	storageUsageClient              storage.UsageClient
+	storageManagementPoliciesClient storagePolicies.ManagementPoliciesClient
 
 	// Traffic Manager
 	trafficManagerGeographialHierarchiesClient trafficmanager.GeographicHierarchiesClient
@@ -612,6 +635,14 @@ func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth
 	c.configureClient(&scaleSetsClient.Client, auth)
 	c.vmScaleSetClient = scaleSetsClient
 
+	scaleSetRollingUpgradesClient := compute.NewVirtualMachineScaleSetRollingUpgradesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scaleSetRollingUpgradesClient.Client, auth)
+	c.vmScaleSetRollingUpgradesClient = scaleSetRollingUpgradesClient
+
+	scaleSetVMsClient := compute.NewVirtualMachineScaleSetVMsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scaleSetVMsClient.Client, auth)
+	c.vmScaleSetVMsClient = scaleSetVMsClient
+
 	virtualMachinesClient := compute.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&virtualMachinesClient.Client, auth)
 	c.vmClient = virtualMachinesClient
@@ -716,6 +747,10 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.postgresqlVirtualNetworkRulesClient = postgresqlVNRClient
 
 	// SQL Azure
+	sqlBLTRPClient := sql.NewBackupLongTermRetentionPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlBLTRPClient.Client, auth)
+	c.sqlBackupLongTermRetentionPoliciesClient = sqlBLTRPClient
+
 	sqlDBClient := sql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlDBClient.Client, auth)
 	c.sqlDatabasesClient = sqlDBClient
@@ -727,6 +762,22 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	sqlDTDPClient.SkipResourceProviderRegistration = c.skipProviderRegistration
 	c.sqlDatabaseThreatDetectionPoliciesClient = sqlDTDPClient
 
+	sqlDVAClient := sqlPreview.NewDatabaseVulnerabilityAssessmentsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlDVAClient.Client, auth)
+	c.sqlDatabaseVulnerabilityAssessmentsClient = sqlDVAClient
+
+	sqlEncryptionProtectorsClient := sql.NewEncryptionProtectorsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlEncryptionProtectorsClient.Client, auth)
+	c.sqlEncryptionProtectorsClient = sqlEncryptionProtectorsClient
+
+	sqlESBAPClient := sqlPreview.NewExtendedServerBlobAuditingPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlESBAPClient.Client, auth)
+	c.sqlExtendedServerBlobAuditingPoliciesClient = sqlESBAPClient
+
+	sqlFGClient := sql.NewFailoverGroupsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlFGClient.Client, auth)
+	c.sqlFailoverGroupsClient = sqlFGClient
+
 	sqlFWClient := sql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlFWClient.Client, auth)
 	c.sqlFirewallRulesClient = sqlFWClient
@@ -735,6 +786,10 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.configureClient(&sqlEPClient.Client, auth)
 	c.sqlElasticPoolsClient = sqlEPClient
 
+	MsSqlBSTRPClient := MsSql.NewBackupShortTermRetentionPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&MsSqlBSTRPClient.Client, auth)
+	c.msSqlBackupShortTermRetentionPoliciesClient = MsSqlBSTRPClient
+
 	MsSqlEPClient := MsSql.NewElasticPoolsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&MsSqlEPClient.Client, auth)
 	c.msSqlElasticPoolsClient = MsSqlEPClient
@@ -747,6 +802,10 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.configureClient(&sqlADClient.Client, auth)
 	c.sqlServerAzureADAdministratorsClient = sqlADClient
 
+	sqlServerKeysClient := sql.NewServerKeysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlServerKeysClient.Client, auth)
+	c.sqlServerKeysClient = sqlServerKeysClient
+
 	sqlVNRClient := sql.NewVirtualNetworkRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlVNRClient.Client, auth)
 	c.sqlVirtualNetworkRulesClient = sqlVNRClient
@@ -897,6 +956,14 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&azureFirewallsClient.Client, auth)
 	c.azureFirewallsClient = azureFirewallsClient
 
+	connectionMonitorsClient := network.NewConnectionMonitorsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&connectionMonitorsClient.Client, auth)
+	c.connectionMonitorsClient = connectionMonitorsClient
+
+	ddosProtectionPlanClient := network.NewDdosProtectionPlansClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&ddosProtectionPlanClient.Client, auth)
+	c.ddosProtectionPlanClient = ddosProtectionPlanClient
+
 	expressRouteAuthsClient := network.NewExpressRouteCircuitAuthorizationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&expressRouteAuthsClient.Client, auth)
 	c.expressRouteAuthsClient = expressRouteAuthsClient
@@ -945,6 +1012,14 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&publicIPAddressesClient.Client, auth)
 	c.publicIPClient = publicIPAddressesClient
 
+	publicIPPrefixesClient := network.NewPublicIPPrefixesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&publicIPPrefixesClient.Client, auth)
+	c.publicIPPrefixesClient = publicIPPrefixesClient
+
+	routeFiltersClient := network.NewRouteFiltersClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&routeFiltersClient.Client, auth)
+	c.routeFiltersClient = routeFiltersClient
+
 	routesClient := network.NewRoutesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&routesClient.Client, auth)
 	c.routesClient = routesClient
@@ -969,6 +1044,22 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&userAssignedIdentitiesClient.Client, auth)
 	c.userAssignedIdentitiesClient = userAssignedIdentitiesClient
 
+	virtualHubsClient := network.NewVirtualHubsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualHubsClient.Client, auth)
+	c.virtualHubsClient = virtualHubsClient
+
+	virtualWansClient := network.NewVirtualWansClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualWansClient.Client, auth)
+	c.virtualWansClient = virtualWansClient
+
+	vpnGatewaysClient := network.NewVpnGatewaysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnGatewaysClient.Client, auth)
+	c.vpnGatewaysClient = vpnGatewaysClient
+
+	vpnSitesClient := network.NewVpnSitesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnSitesClient.Client, auth)
+	c.vpnSitesClient = vpnSitesClient
+
 	watchersClient := network.NewWatchersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&watchersClient.Client, auth)
 	c.watcherClient = watchersClient
@@ -1137,6 +1228,10 @@ func (c *ArmClient) registerStorageClients(endpoint, subscriptionId string, auth
 	usageClient := storage.NewUsageClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&usageClient.Client, auth)
 	c.storageUsageClient = usageClient
+
+	managementPoliciesClient := storagePolicies.NewManagementPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&managementPoliciesClient.Client, auth)
+	c.storageManagementPoliciesClient = managementPoliciesClient
 }
 
 func (c *ArmClient) registerTrafficManagerClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -45,6 +45,25 @@ func dataSourceArmKeyVaultKey() *schema.Resource {
 			},
 
 			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"not_before_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"expiration_date": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
@@ -70,10 +89,15 @@ func dataSourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) erro
 
 	vaultUri := d.Get("vault_uri").(string)
 	name := d.Get("name").(string)
+	// "" indicates the latest version
+	version := d.Get("version").(string)
 
-	resp, err := client.GetKey(ctx, vaultUri, name, "")
+	resp, err := client.GetKey(ctx, vaultUri, name, version)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
+			if version != "" {
+				return fmt.Errorf("Version %q of Key %q was not found in Key Vault at URI %q", version, name, vaultUri)
+			}
 			return fmt.Errorf("Key %q was not found in Key Vault at URI %q", name, vaultUri)
 		}
 
@@ -101,6 +125,19 @@ func dataSourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) erro
 
 	d.Set("version", parsedId.Version)
 
+	if attributes := resp.Attributes; attributes != nil {
+		d.Set("not_before_date", flattenKeyVaultChildItemDate(attributes.NotBefore))
+		d.Set("expiration_date", flattenKeyVaultChildItemDate(attributes.Expires))
+	}
+
+	versions, err := keyVaultKeyVersions(ctx, client, parsedId.KeyVaultBaseUrl, parsedId.Name)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("Error setting `versions`: %+v", err)
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -22,6 +22,9 @@ func TestAccDataSourceAzureRMKeyVaultKey_complete(t *testing.T) {
 				Config: testAccDataSourceKeyVaultKey_complete(rString, location),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(dataSourceName, "key_type", "RSA"),
+					resource.TestCheckResourceAttr(dataSourceName, "not_before_date", "2019-01-01T01:02:03Z"),
+					resource.TestCheckResourceAttr(dataSourceName, "expiration_date", "2030-01-01T01:02:03Z"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "1"),
 					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(dataSourceName, "tags.hello", "world"),
 				),
@@ -37,6 +40,7 @@ func testAccDataSourceKeyVaultKey_complete(rString string, location string) stri
 data "azurerm_key_vault_key" "test" {
   name      = "${azurerm_key_vault_key.test.name}"
   vault_uri = "${azurerm_key_vault_key.test.vault_uri}"
+  version   = "${azurerm_key_vault_key.test.version}"
 }
 `, testAccAzureRMKeyVaultKey_complete(rString, location))
 }
@@ -40,6 +40,25 @@ func dataSourceArmKeyVaultSecret() *schema.Resource {
 			},
 
 			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"not_before_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"expiration_date": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
@@ -55,11 +74,15 @@ func dataSourceArmKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) e
 
 	name := d.Get("name").(string)
 	vaultUri := d.Get("vault_uri").(string)
+	// "" indicates the latest version
+	version := d.Get("version").(string)
 
-	// we always want to get the latest version
-	resp, err := client.GetSecret(ctx, vaultUri, name, "")
+	resp, err := client.GetSecret(ctx, vaultUri, name, version)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
+			if version != "" {
+				return fmt.Errorf("Version %q of KeyVault Secret %q (KeyVault URI %q) does not exist", version, name, vaultUri)
+			}
 			return fmt.Errorf("KeyVault Secret %q (KeyVault URI %q) does not exist", name, vaultUri)
 		}
 		return fmt.Errorf("Error making Read request on Azure KeyVault Secret %s: %+v", name, err)
@@ -79,6 +102,19 @@ func dataSourceArmKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("version", respID.Version)
 	d.Set("content_type", resp.ContentType)
 
+	if attributes := resp.Attributes; attributes != nil {
+		d.Set("not_before_date", flattenKeyVaultChildItemDate(attributes.NotBefore))
+		d.Set("expiration_date", flattenKeyVaultChildItemDate(attributes.Expires))
+	}
+
+	versions, err := keyVaultSecretVersions(ctx, client, respID.KeyVaultBaseUrl, respID.Name)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("Error setting `versions`: %+v", err)
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 	return nil
 }
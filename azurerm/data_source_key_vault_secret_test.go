@@ -45,6 +45,8 @@ func TestAccDataSourceAzureRMKeyVaultSecret_complete(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(dataSourceName, "value", "<rick><morty /></rick>"),
+					resource.TestCheckResourceAttr(dataSourceName, "not_before_date", "2019-01-01T01:02:03Z"),
+					resource.TestCheckResourceAttr(dataSourceName, "expiration_date", "2030-01-01T01:02:03Z"),
 					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(dataSourceName, "tags.hello", "world"),
 				),
@@ -53,6 +55,30 @@ func TestAccDataSourceAzureRMKeyVaultSecret_complete(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAzureRMKeyVaultSecret_version(t *testing.T) {
+	dataSourceName := "data.azurerm_key_vault_secret.test"
+
+	rString := acctest.RandString(8)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultSecret_basic(rString, location),
+			},
+			{
+				Config: testAccDataSourceKeyVaultSecret_version(rString, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "value", "rick-and-morty"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceKeyVaultSecret_basic(rString string, location string) string {
 	r := testAccAzureRMKeyVaultSecret_basic(rString, location)
 	return fmt.Sprintf(`
@@ -76,3 +102,16 @@ data "azurerm_key_vault_secret" "test" {
 }
 `, r)
 }
+
+func testAccDataSourceKeyVaultSecret_version(rString string, location string) string {
+	r := testAccAzureRMKeyVaultSecret_basicUpdated(rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_secret" "test" {
+  name      = "${azurerm_key_vault_secret.test.name}"
+  vault_uri = "${azurerm_key_vault_secret.test.vault_uri}"
+  version   = "${element(azurerm_key_vault_secret.test.versions, 0)}"
+}
+`, r)
+}
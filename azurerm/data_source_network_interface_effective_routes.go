@@ -0,0 +1,131 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmNetworkInterfaceEffectiveRoutes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmNetworkInterfaceEffectiveRoutesRead,
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"route": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"address_prefixes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"next_hop_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"next_hop_ip_addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmNetworkInterfaceEffectiveRoutesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("network_interface_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	iface, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(iface.Response) {
+			return fmt.Errorf("Error: Network Interface %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+		return fmt.Errorf("Error making Read request on Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	future, err := client.GetEffectiveRouteTable(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Effective Routes for Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Effective Routes for Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	result, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Effective Routes result for Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(*iface.ID)
+
+	if err := d.Set("route", flattenArmNetworkInterfaceEffectiveRoutes(result.Value)); err != nil {
+		return fmt.Errorf("Error setting `route`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmNetworkInterfaceEffectiveRoutes(input *[]network.EffectiveRoute) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, route := range *input {
+		output := map[string]interface{}{
+			"source":        string(route.Source),
+			"state":         string(route.State),
+			"next_hop_type": string(route.NextHopType),
+		}
+
+		if name := route.Name; name != nil {
+			output["name"] = *name
+		}
+		if prefixes := route.AddressPrefix; prefixes != nil {
+			output["address_prefixes"] = *prefixes
+		}
+		if addresses := route.NextHopIPAddress; addresses != nil {
+			output["next_hop_ip_addresses"] = *addresses
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
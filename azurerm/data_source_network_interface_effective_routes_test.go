@@ -0,0 +1,47 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccDataSourceAzureRMNetworkInterfaceEffectiveRoutes_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_network_interface_effective_routes.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMNetworkInterfaceEffectiveRoutes_basicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "route.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "route.0.source", "Default"),
+					resource.TestCheckResourceAttr(dataSourceName, "route.0.state", "Active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMNetworkInterfaceEffectiveRoutes_basicConfig(rInt int, location string) string {
+	config := testAccDataSourceAzureRMNetworkWatcherDiagnostics_base(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_interface_effective_routes" "test" {
+  network_interface_name = "${azurerm_network_interface.test.name}"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+
+  depends_on = [
+    "azurerm_virtual_machine_extension.test",
+    "azurerm_subnet_network_security_group_association.test",
+  ]
+}
+`, config)
+}
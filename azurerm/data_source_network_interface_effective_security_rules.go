@@ -0,0 +1,233 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmNetworkInterfaceEffectiveSecurityRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmNetworkInterfaceEffectiveSecurityRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"network_security_group": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"security_rule": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"priority": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+
+									"direction": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"access": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"protocol": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"source_port_ranges": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"destination_port_ranges": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"source_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"destination_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"expanded_source_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"expanded_destination_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmNetworkInterfaceEffectiveSecurityRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("network_interface_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	iface, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(iface.Response) {
+			return fmt.Errorf("Error: Network Interface %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+		return fmt.Errorf("Error making Read request on Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	future, err := client.ListEffectiveNetworkSecurityGroups(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Effective Security Rules for Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Effective Security Rules for Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	result, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Effective Security Rules result for Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(*iface.ID)
+
+	if err := d.Set("network_security_group", flattenArmNetworkInterfaceEffectiveSecurityGroups(result.Value)); err != nil {
+		return fmt.Errorf("Error setting `network_security_group`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmNetworkInterfaceEffectiveSecurityGroups(input *[]network.EffectiveNetworkSecurityGroup) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, group := range *input {
+		output := make(map[string]interface{})
+
+		if nsg := group.NetworkSecurityGroup; nsg != nil && nsg.ID != nil {
+			output["id"] = *nsg.ID
+		}
+
+		if association := group.Association; association != nil {
+			if subnet := association.Subnet; subnet != nil && subnet.ID != nil {
+				output["subnet_id"] = *subnet.ID
+			}
+			if nic := association.NetworkInterface; nic != nil && nic.ID != nil {
+				output["network_interface_id"] = *nic.ID
+			}
+		}
+
+		output["security_rule"] = flattenArmNetworkInterfaceEffectiveSecurityRules(group.EffectiveSecurityRules)
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenArmNetworkInterfaceEffectiveSecurityRules(input *[]network.EffectiveNetworkSecurityRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		output := map[string]interface{}{
+			"direction": string(rule.Direction),
+			"access":    string(rule.Access),
+			"protocol":  string(rule.Protocol),
+		}
+
+		if name := rule.Name; name != nil {
+			output["name"] = *name
+		}
+		if priority := rule.Priority; priority != nil {
+			output["priority"] = int(*priority)
+		}
+
+		// the API returns either the singular or the plural form of each field, so combine them
+		output["source_port_ranges"] = mergeArmNetworkInterfaceEffectiveSecurityRuleValues(rule.SourcePortRange, rule.SourcePortRanges)
+		output["destination_port_ranges"] = mergeArmNetworkInterfaceEffectiveSecurityRuleValues(rule.DestinationPortRange, rule.DestinationPortRanges)
+		output["source_address_prefixes"] = mergeArmNetworkInterfaceEffectiveSecurityRuleValues(rule.SourceAddressPrefix, rule.SourceAddressPrefixes)
+		output["destination_address_prefixes"] = mergeArmNetworkInterfaceEffectiveSecurityRuleValues(rule.DestinationAddressPrefix, rule.DestinationAddressPrefixes)
+		output["expanded_source_address_prefixes"] = mergeArmNetworkInterfaceEffectiveSecurityRuleValues(nil, rule.ExpandedSourceAddressPrefix)
+		output["expanded_destination_address_prefixes"] = mergeArmNetworkInterfaceEffectiveSecurityRuleValues(nil, rule.ExpandedDestinationAddressPrefix)
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func mergeArmNetworkInterfaceEffectiveSecurityRuleValues(single *string, multiple *[]string) []interface{} {
+	results := make([]interface{}, 0)
+
+	if single != nil && *single != "" {
+		results = append(results, *single)
+	}
+	if multiple != nil {
+		for _, v := range *multiple {
+			results = append(results, v)
+		}
+	}
+
+	return results
+}
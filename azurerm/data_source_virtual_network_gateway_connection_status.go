@@ -0,0 +1,146 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmVirtualNetworkGatewayConnectionStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVirtualNetworkGatewayConnectionStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"connection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ingress_bytes_transferred": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"egress_bytes_transferred": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"tunnel_connection_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tunnel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"connection_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"ingress_bytes_transferred": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"egress_bytes_transferred": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"last_connection_established_utc_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmVirtualNetworkGatewayConnectionStatusRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vnetGatewayConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Virtual Network Gateway Connection %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+
+		return fmt.Errorf("Error making Read request on Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	if props := resp.VirtualNetworkGatewayConnectionPropertiesFormat; props != nil {
+		d.Set("connection_status", string(props.ConnectionStatus))
+
+		ingressBytes := 0
+		if v := props.IngressBytesTransferred; v != nil {
+			ingressBytes = int(*v)
+		}
+		d.Set("ingress_bytes_transferred", ingressBytes)
+
+		egressBytes := 0
+		if v := props.EgressBytesTransferred; v != nil {
+			egressBytes = int(*v)
+		}
+		d.Set("egress_bytes_transferred", egressBytes)
+
+		tunnels := flattenArmVirtualNetworkGatewayConnectionTunnelStatus(props.TunnelConnectionStatus)
+		if err := d.Set("tunnel_connection_status", tunnels); err != nil {
+			return fmt.Errorf("Error setting `tunnel_connection_status`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func flattenArmVirtualNetworkGatewayConnectionTunnelStatus(input *[]network.TunnelConnectionHealth) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, tunnel := range *input {
+		output := map[string]interface{}{
+			"connection_status": string(tunnel.ConnectionStatus),
+		}
+
+		if v := tunnel.Tunnel; v != nil {
+			output["tunnel"] = *v
+		}
+		if v := tunnel.IngressBytesTransferred; v != nil {
+			output["ingress_bytes_transferred"] = int(*v)
+		}
+		if v := tunnel.EgressBytesTransferred; v != nil {
+			output["egress_bytes_transferred"] = int(*v)
+		}
+		if v := tunnel.LastConnectionEstablishedUtcTime; v != nil {
+			output["last_connection_established_utc_time"] = *v
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
@@ -0,0 +1,43 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMDataSourceVirtualNetworkGatewayConnectionStatus_sitetosite(t *testing.T) {
+	dataSourceName := "data.azurerm_virtual_network_gateway_connection_status.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDataSourceVirtualNetworkGatewayConnectionStatus_sitetosite(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "connection_status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ingress_bytes_transferred"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "egress_bytes_transferred"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMDataSourceVirtualNetworkGatewayConnectionStatus_sitetosite(rInt int, location string) string {
+	config := testAccAzureRMVirtualNetworkGatewayConnection_sitetosite(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_gateway_connection_status" "test" {
+  name                = "${azurerm_virtual_network_gateway_connection.test.name}"
+  resource_group_name = "${azurerm_virtual_network_gateway_connection.test.resource_group_name}"
+}
+`, config)
+}
@@ -0,0 +1,126 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// features contains the behaviours which can be toggled via the `features` block in the Provider block
+type features struct {
+	keyVault keyVaultFeatures
+}
+
+type keyVaultFeatures struct {
+	purgeSoftDeletedVaultsOnDestroy bool
+	recoverSoftDeletedVaults        bool
+
+	purgeSoftDeletedKeysOnDestroy bool
+	recoverSoftDeletedKeys        bool
+
+	purgeSoftDeletedSecretsOnDestroy bool
+	recoverSoftDeletedSecrets        bool
+
+	purgeSoftDeletedCertificatesOnDestroy bool
+	recoverSoftDeletedCertificates        bool
+}
+
+// defaultFeatures returns the behaviours used when no `features` block is specified
+func defaultFeatures() features {
+	return features{
+		keyVault: keyVaultFeatures{
+			purgeSoftDeletedVaultsOnDestroy:       true,
+			recoverSoftDeletedVaults:              true,
+			purgeSoftDeletedKeysOnDestroy:         true,
+			recoverSoftDeletedKeys:                true,
+			purgeSoftDeletedSecretsOnDestroy:      true,
+			recoverSoftDeletedSecrets:             true,
+			purgeSoftDeletedCertificatesOnDestroy: true,
+			recoverSoftDeletedCertificates:        true,
+		},
+	}
+}
+
+func schemaFeatures() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_vault": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"purge_soft_deleted_key_vaults_on_destroy": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+							"recover_soft_deleted_key_vaults": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+							"purge_soft_deleted_keys_on_destroy": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+							"recover_soft_deleted_keys": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+							"purge_soft_deleted_secrets_on_destroy": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+							"recover_soft_deleted_secrets": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+							"purge_soft_deleted_certificates_on_destroy": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+							"recover_soft_deleted_certificates": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandFeatures(input []interface{}) features {
+	output := defaultFeatures()
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	v := input[0].(map[string]interface{})
+
+	if raw, ok := v["key_vault"].([]interface{}); ok && len(raw) > 0 && raw[0] != nil {
+		keyVault := raw[0].(map[string]interface{})
+		output.keyVault = keyVaultFeatures{
+			purgeSoftDeletedVaultsOnDestroy:       keyVault["purge_soft_deleted_key_vaults_on_destroy"].(bool),
+			recoverSoftDeletedVaults:              keyVault["recover_soft_deleted_key_vaults"].(bool),
+			purgeSoftDeletedKeysOnDestroy:         keyVault["purge_soft_deleted_keys_on_destroy"].(bool),
+			recoverSoftDeletedKeys:                keyVault["recover_soft_deleted_keys"].(bool),
+			purgeSoftDeletedSecretsOnDestroy:      keyVault["purge_soft_deleted_secrets_on_destroy"].(bool),
+			recoverSoftDeletedSecrets:             keyVault["recover_soft_deleted_secrets"].(bool),
+			purgeSoftDeletedCertificatesOnDestroy: keyVault["purge_soft_deleted_certificates_on_destroy"].(bool),
+			recoverSoftDeletedCertificates:        keyVault["recover_soft_deleted_certificates"].(bool),
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,68 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandFeatures(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected features
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: defaultFeatures(),
+		},
+		{
+			Name: "Empty Key Vault Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{},
+				},
+			},
+			Expected: defaultFeatures(),
+		},
+		{
+			Name: "Key Vault Features Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_deleted_key_vaults_on_destroy":   false,
+							"recover_soft_deleted_key_vaults":            false,
+							"purge_soft_deleted_keys_on_destroy":         false,
+							"recover_soft_deleted_keys":                  true,
+							"purge_soft_deleted_secrets_on_destroy":      false,
+							"recover_soft_deleted_secrets":               true,
+							"purge_soft_deleted_certificates_on_destroy": true,
+							"recover_soft_deleted_certificates":          false,
+						},
+					},
+				},
+			},
+			Expected: features{
+				keyVault: keyVaultFeatures{
+					purgeSoftDeletedVaultsOnDestroy:       false,
+					recoverSoftDeletedVaults:              false,
+					purgeSoftDeletedKeysOnDestroy:         false,
+					recoverSoftDeletedKeys:                true,
+					purgeSoftDeletedSecretsOnDestroy:      false,
+					recoverSoftDeletedSecrets:             true,
+					purgeSoftDeletedCertificatesOnDestroy: true,
+					recoverSoftDeletedCertificates:        false,
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q", v.Name)
+		actual := expandFeatures(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package azure
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+// the Create Modes supported by the MySQL, PostgreSQL and MariaDB Servers
+const (
+	DatabaseServerCreateModeDefault            = "Default"
+	DatabaseServerCreateModeGeoRestore         = "GeoRestore"
+	DatabaseServerCreateModePointInTimeRestore = "PointInTimeRestore"
+	DatabaseServerCreateModeReplica            = "Replica"
+)
+
+// DatabaseServerCreateMode is the engine-agnostic representation of how a MySQL, PostgreSQL
+// or MariaDB Server should be created, which each engine maps into its own SDK types
+type DatabaseServerCreateMode struct {
+	Mode               string
+	SourceServerID     string
+	RestorePointInTime *date.Time
+}
+
+func SchemaDatabaseServerCreateMode(supportedModes []string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      DatabaseServerCreateModeDefault,
+		ValidateFunc: validation.StringInSlice(supportedModes, false),
+	}
+}
+
+// SchemaDatabaseServerAdministratorLogin returns the schema for the Administrator Login, which is only
+// used when `create_mode` is `Default` - other Create Modes inherit it from the Source Server
+func SchemaDatabaseServerAdministratorLogin() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: validate.NoEmptyStrings,
+		DiffSuppressFunc: func(_, _, _ string, d *schema.ResourceData) bool {
+			return d.Get("create_mode").(string) != DatabaseServerCreateModeDefault
+		},
+	}
+}
+
+func SchemaDatabaseServerAdministratorLoginPassword() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		ValidateFunc: validate.NoEmptyStrings,
+	}
+}
+
+func SchemaDatabaseServerCreationSourceServerID() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: ValidateResourceID,
+	}
+}
+
+func SchemaDatabaseServerRestorePointInTime() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validate.RFC3339Time,
+	}
+}
+
+func ExpandDatabaseServerCreateMode(d *schema.ResourceData) *DatabaseServerCreateMode {
+	result := DatabaseServerCreateMode{
+		Mode:           d.Get("create_mode").(string),
+		SourceServerID: d.Get("creation_source_server_id").(string),
+	}
+
+	if v := d.Get("restore_point_in_time").(string); v != "" {
+		// this has already been validated by `validate.RFC3339Time`
+		t, _ := time.Parse(time.RFC3339, v)
+		result.RestorePointInTime = &date.Time{Time: t}
+	}
+
+	return &result
+}
+
+// FlattenDatabaseServerCreateMode sets the Create Mode fields into the state. The API only exposes
+// the Source Server for Replicas, so the values from the config are retained for all other modes.
+func FlattenDatabaseServerCreateMode(d *schema.ResourceData, masterServerId *string) {
+	if masterServerId != nil && *masterServerId != "" {
+		d.Set("create_mode", DatabaseServerCreateModeReplica)
+		d.Set("creation_source_server_id", *masterServerId)
+		return
+	}
+
+	// when importing there's nothing in the state, in which case this is the default
+	if _, ok := d.GetOk("create_mode"); !ok {
+		d.Set("create_mode", DatabaseServerCreateModeDefault)
+	}
+}
+
+// DatabaseServerCreateModeCustomizeDiff validates the combination of `create_mode`, `creation_source_server_id`,
+// `restore_point_in_time` and the Administrator credentials, which are only used when `create_mode` is `Default`
+func DatabaseServerCreateModeCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	// values which are interpolated aren't known at this point, so are assumed to be set
+	isSet := func(key string) bool {
+		if !d.NewValueKnown(key) {
+			return true
+		}
+
+		_, ok := d.GetOk(key)
+		return ok
+	}
+
+	// an imported Server won't have a password in the state, so the credentials are only required for new Servers
+	isExisting := d.Id() != ""
+
+	return validateDatabaseServerCreateMode(
+		d.Get("create_mode").(string),
+		isSet("creation_source_server_id"),
+		isSet("restore_point_in_time"),
+		isExisting || isSet("administrator_login"),
+		isExisting || isSet("administrator_login_password"))
+}
+
+func validateDatabaseServerCreateMode(mode string, hasSourceServerId bool, hasRestorePointInTime bool, hasAdministratorLogin bool, hasAdministratorLoginPassword bool) error {
+	if mode == DatabaseServerCreateModeDefault {
+		if hasSourceServerId {
+			return fmt.Errorf("`creation_source_server_id` can only be specified when `create_mode` is not `%s`", DatabaseServerCreateModeDefault)
+		}
+
+		if !hasAdministratorLogin {
+			return fmt.Errorf("`administrator_login` must be specified when `create_mode` is `%s`", DatabaseServerCreateModeDefault)
+		}
+
+		if !hasAdministratorLoginPassword {
+			return fmt.Errorf("`administrator_login_password` must be specified when `create_mode` is `%s`", DatabaseServerCreateModeDefault)
+		}
+	} else if !hasSourceServerId {
+		return fmt.Errorf("`creation_source_server_id` must be specified when `create_mode` is `%s`", mode)
+	}
+
+	if mode == DatabaseServerCreateModePointInTimeRestore {
+		if !hasRestorePointInTime {
+			return fmt.Errorf("`restore_point_in_time` must be specified when `create_mode` is `%s`", DatabaseServerCreateModePointInTimeRestore)
+		}
+	} else if hasRestorePointInTime {
+		return fmt.Errorf("`restore_point_in_time` can only be specified when `create_mode` is `%s`", DatabaseServerCreateModePointInTimeRestore)
+	}
+
+	return nil
+}
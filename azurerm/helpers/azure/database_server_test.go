@@ -0,0 +1,90 @@
+package azure
+
+import "testing"
+
+func TestValidateDatabaseServerCreateMode(t *testing.T) {
+	cases := []struct {
+		Mode                          string
+		HasSourceServerID             bool
+		HasRestorePointInTime         bool
+		HasAdministratorLogin         bool
+		HasAdministratorLoginPassword bool
+		Errors                        bool
+	}{
+		{
+			Mode:                          DatabaseServerCreateModeDefault,
+			HasAdministratorLogin:         true,
+			HasAdministratorLoginPassword: true,
+			Errors:                        false,
+		},
+		{
+			Mode:                          DatabaseServerCreateModeDefault,
+			HasAdministratorLoginPassword: true,
+			Errors:                        true,
+		},
+		{
+			Mode:                  DatabaseServerCreateModeDefault,
+			HasAdministratorLogin: true,
+			Errors:                true,
+		},
+		{
+			Mode:                          DatabaseServerCreateModeDefault,
+			HasSourceServerID:             true,
+			HasAdministratorLogin:         true,
+			HasAdministratorLoginPassword: true,
+			Errors:                        true,
+		},
+		{
+			Mode:                          DatabaseServerCreateModeDefault,
+			HasRestorePointInTime:         true,
+			HasAdministratorLogin:         true,
+			HasAdministratorLoginPassword: true,
+			Errors:                        true,
+		},
+		{
+			Mode:   DatabaseServerCreateModeReplica,
+			Errors: true,
+		},
+		{
+			Mode:              DatabaseServerCreateModeReplica,
+			HasSourceServerID: true,
+			Errors:            false,
+		},
+		{
+			Mode:              DatabaseServerCreateModeGeoRestore,
+			HasSourceServerID: true,
+			Errors:            false,
+		},
+		{
+			Mode:                  DatabaseServerCreateModeGeoRestore,
+			HasSourceServerID:     true,
+			HasRestorePointInTime: true,
+			Errors:                true,
+		},
+		{
+			Mode:              DatabaseServerCreateModePointInTimeRestore,
+			HasSourceServerID: true,
+			Errors:            true,
+		},
+		{
+			Mode:                  DatabaseServerCreateModePointInTimeRestore,
+			HasRestorePointInTime: true,
+			Errors:                true,
+		},
+		{
+			Mode:                  DatabaseServerCreateModePointInTimeRestore,
+			HasSourceServerID:     true,
+			HasRestorePointInTime: true,
+			Errors:                false,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateDatabaseServerCreateMode(tc.Mode, tc.HasSourceServerID, tc.HasRestorePointInTime, tc.HasAdministratorLogin, tc.HasAdministratorLoginPassword)
+		hasErrors := err != nil
+
+		if tc.Errors != hasErrors {
+			t.Fatalf("Expected validateDatabaseServerCreateMode to have errors '%t' for %+v - got '%t' (%+v)", tc.Errors, tc, hasErrors, err)
+		}
+	}
+}
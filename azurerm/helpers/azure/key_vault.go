@@ -0,0 +1,33 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+)
+
+// GetKeyVaultIDFromBaseUrl looks up the Resource ID of the Key Vault with the specified Base URL
+// (e.g. `https://example.vault.azure.net/`) - returning nil if no Key Vault was found in the Subscription
+func GetKeyVaultIDFromBaseUrl(ctx context.Context, client keyvault.VaultsClient, keyVaultUrl string) (*string, error) {
+	list, err := client.ListBySubscriptionComplete(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Key Vaults: %+v", err)
+	}
+
+	for list.NotDone() {
+		v := list.Value()
+		if props := v.Properties; props != nil && props.VaultURI != nil {
+			if strings.EqualFold(strings.TrimSuffix(*props.VaultURI, "/"), strings.TrimSuffix(keyVaultUrl, "/")) {
+				return v.ID, nil
+			}
+		}
+
+		if err := list.Next(); err != nil {
+			return nil, fmt.Errorf("Error listing Key Vaults: %+v", err)
+		}
+	}
+
+	return nil, nil
+}
@@ -15,6 +15,15 @@ func ValidateMsSqlServerName(i interface{}, k string) (_ []string, errors []erro
 	return nil, errors
 }
 
+//Your failover group name can contain only lowercase letters, numbers, and '-', but can't start or end with '-' or have more than 63 characters.
+func ValidateMsSqlFailoverGroupName(i interface{}, k string) (_ []string, errors []error) {
+	if m, regexErrs := validate.RegExHelper(i, k, `^[0-9a-z]([-0-9a-z]{0,61}[0-9a-z])?$`); !m {
+		errors = append(regexErrs, fmt.Errorf("%q can contain only lowercase letters, numbers, and '-', but can't start or end with '-' or have more than 63 characters.", k))
+	}
+
+	return nil, errors
+}
+
 //Your database name can't end with '.' or ' ', can't contain '<,>,*,%,&,:,\,/,?' or control characters, and can't have more than 128 characters.
 func ValidateMsSqlDatabaseName(i interface{}, k string) (_ []string, errors []error) {
 	if m, regexErrs := validate.RegExHelper(i, k, `^[^<>*%&:\\\/?]{0,127}[^\s.<>*%&:\\\/?]$`); !m {
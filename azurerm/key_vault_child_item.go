@@ -0,0 +1,153 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func expandKeyVaultChildItemDate(input string) *date.UnixTime {
+	if input == "" {
+		return nil
+	}
+
+	// this has already been validated by `validateRFC3339Date`
+	t, _ := time.Parse(time.RFC3339, input)
+	result := date.UnixTime(t)
+	return &result
+}
+
+func flattenKeyVaultChildItemDate(input *date.UnixTime) string {
+	if input == nil {
+		return ""
+	}
+
+	return time.Time(*input).Format(time.RFC3339)
+}
+
+// keyVaultChildItemDatesCustomizeDiff forces a new resource when `not_before_date` or `expiration_date` are removed,
+// since the API omits unset dates from the request and as such there's no way to clear them on an existing item
+func keyVaultChildItemDatesCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	for _, key := range []string{"not_before_date", "expiration_date"} {
+		if !d.NewValueKnown(key) {
+			continue
+		}
+
+		old, new := d.GetChange(key)
+		if old.(string) != "" && new.(string) == "" {
+			if err := d.ForceNew(key); err != nil {
+				return fmt.Errorf("Error forcing a new resource when removing `%s`: %+v", key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+type keyVaultChildItemVersion struct {
+	version string
+	created time.Time
+}
+
+// sortKeyVaultChildItemVersions returns the versions of a Key or Secret, ordered from oldest to newest
+func sortKeyVaultChildItemVersions(input []keyVaultChildItemVersion) []interface{} {
+	sort.Slice(input, func(i, j int) bool {
+		if input[i].created.Equal(input[j].created) {
+			return input[i].version < input[j].version
+		}
+
+		return input[i].created.Before(input[j].created)
+	})
+
+	output := make([]interface{}, 0, len(input))
+	for _, v := range input {
+		output = append(output, v.version)
+	}
+	return output
+}
+
+func keyVaultSecretVersions(ctx context.Context, client keyvault.BaseClient, keyVaultBaseUrl string, name string) ([]interface{}, error) {
+	versions := make([]keyVaultChildItemVersion, 0)
+
+	list, err := client.GetSecretVersionsComplete(ctx, keyVaultBaseUrl, name, nil)
+	if err != nil {
+		// the Access Policy may grant `get` but not `list` - in which case the versions aren't available
+		if utils.ResponseWasForbidden(list.Response().Response) {
+			log.Printf("[WARN] Unable to list versions of Secret %q (Key Vault %q) since the `list` permission isn't granted - leaving `versions` empty", name, keyVaultBaseUrl)
+			return make([]interface{}, 0), nil
+		}
+
+		return nil, fmt.Errorf("Error listing versions of Secret %q (Key Vault %q): %+v", name, keyVaultBaseUrl, err)
+	}
+
+	for list.NotDone() {
+		item := list.Value()
+		if item.ID != nil {
+			id, err := azure.ParseKeyVaultChildID(*item.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			version := keyVaultChildItemVersion{
+				version: id.Version,
+			}
+			if attributes := item.Attributes; attributes != nil && attributes.Created != nil {
+				version.created = time.Time(*attributes.Created)
+			}
+			versions = append(versions, version)
+		}
+
+		if err := list.Next(); err != nil {
+			return nil, fmt.Errorf("Error listing versions of Secret %q (Key Vault %q): %+v", name, keyVaultBaseUrl, err)
+		}
+	}
+
+	return sortKeyVaultChildItemVersions(versions), nil
+}
+
+func keyVaultKeyVersions(ctx context.Context, client keyvault.BaseClient, keyVaultBaseUrl string, name string) ([]interface{}, error) {
+	versions := make([]keyVaultChildItemVersion, 0)
+
+	list, err := client.GetKeyVersionsComplete(ctx, keyVaultBaseUrl, name, nil)
+	if err != nil {
+		// the Access Policy may grant `get` but not `list` - in which case the versions aren't available
+		if utils.ResponseWasForbidden(list.Response().Response) {
+			log.Printf("[WARN] Unable to list versions of Key %q (Key Vault %q) since the `list` permission isn't granted - leaving `versions` empty", name, keyVaultBaseUrl)
+			return make([]interface{}, 0), nil
+		}
+
+		return nil, fmt.Errorf("Error listing versions of Key %q (Key Vault %q): %+v", name, keyVaultBaseUrl, err)
+	}
+
+	for list.NotDone() {
+		item := list.Value()
+		if item.Kid != nil {
+			id, err := azure.ParseKeyVaultChildID(*item.Kid)
+			if err != nil {
+				return nil, err
+			}
+
+			version := keyVaultChildItemVersion{
+				version: id.Version,
+			}
+			if attributes := item.Attributes; attributes != nil && attributes.Created != nil {
+				version.created = time.Time(*attributes.Created)
+			}
+			versions = append(versions, version)
+		}
+
+		if err := list.Next(); err != nil {
+			return nil, fmt.Errorf("Error listing versions of Key %q (Key Vault %q): %+v", name, keyVaultBaseUrl, err)
+		}
+	}
+
+	return sortKeyVaultChildItemVersions(versions), nil
+}
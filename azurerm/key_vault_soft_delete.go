@@ -0,0 +1,117 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// keyVaultChildItemSoftDelete contains the operations required to recover or purge a soft-deleted Key, Secret or Certificate
+type keyVaultChildItemSoftDelete struct {
+	// itemType is the type of item (e.g. `Secret`) used in log & error messages
+	itemType string
+	// featureFlag is the name of the field in the `features` block which controls recovering this type of item
+	featureFlag string
+
+	name            string
+	keyVaultBaseUrl string
+
+	get        func(ctx context.Context) (autorest.Response, error)
+	getDeleted func(ctx context.Context) (autorest.Response, error)
+	recover    func(ctx context.Context) error
+	purge      func(ctx context.Context) (autorest.Response, error)
+}
+
+// recoverIfSoftDeleted checks for a soft-deleted item with the same name, which would otherwise block the creation of this
+// item - and either recovers it (so that it can be updated with the new values) or returns an error, based on `shouldRecover`
+func (i keyVaultChildItemSoftDelete) recoverIfSoftDeleted(ctx context.Context, shouldRecover bool) error {
+	resp, err := i.getDeleted(ctx)
+	if err != nil {
+		// a Bad Request is returned when Soft Delete isn't enabled for this Key Vault
+		if utils.ResponseWasNotFound(resp) || utils.ResponseWasBadRequest(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error checking for a soft-deleted %s %q (Key Vault %q): %+v", i.itemType, i.name, i.keyVaultBaseUrl, err)
+	}
+
+	if !shouldRecover {
+		return fmt.Errorf("A soft-deleted %s %q exists in Key Vault %q which must be recovered or purged before it can be created - alternatively set `%s` to `true` in the `features` block of the Provider to recover it automatically", i.itemType, i.name, i.keyVaultBaseUrl, i.featureFlag)
+	}
+
+	log.Printf("[DEBUG] Recovering soft-deleted %s %q (Key Vault %q)..", i.itemType, i.name, i.keyVaultBaseUrl)
+	if err := i.recover(ctx); err != nil {
+		return fmt.Errorf("Error recovering soft-deleted %s %q (Key Vault %q): %+v", i.itemType, i.name, i.keyVaultBaseUrl, err)
+	}
+
+	// recovery happens asynchronously, so we need to wait for the item to become available again
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"pending"},
+		Target:                    []string{"available"},
+		Refresh:                   i.refreshFunc(ctx, i.get),
+		Timeout:                   30 * time.Minute,
+		Delay:                     5 * time.Second,
+		PollInterval:              5 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for soft-deleted %s %q (Key Vault %q) to be recovered: %+v", i.itemType, i.name, i.keyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+// purgeSoftDeleted waits for the deleted item to become available and then purges it
+func (i keyVaultChildItemSoftDelete) purgeSoftDeleted(ctx context.Context) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"pending"},
+		Target:                    []string{"available"},
+		Refresh:                   i.refreshFunc(ctx, i.getDeleted),
+		Timeout:                   30 * time.Minute,
+		Delay:                     5 * time.Second,
+		PollInterval:              5 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for %s %q (Key Vault %q) to be soft-deleted: %+v", i.itemType, i.name, i.keyVaultBaseUrl, err)
+	}
+
+	log.Printf("[DEBUG] Purging soft-deleted %s %q (Key Vault %q)..", i.itemType, i.name, i.keyVaultBaseUrl)
+	resp, err := i.purge(ctx)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error purging soft-deleted %s %q (Key Vault %q): %+v", i.itemType, i.name, i.keyVaultBaseUrl, err)
+		}
+	}
+
+	return nil
+}
+
+func (i keyVaultChildItemSoftDelete) refreshFunc(ctx context.Context, get func(ctx context.Context) (autorest.Response, error)) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := get(ctx)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return "pending", "pending", nil
+			}
+
+			return nil, "", err
+		}
+
+		return "available", "available", nil
+	}
+}
+
+// keyVaultRecoveryLevelIsPurgeable determines if a deleted item can be purged, which isn't possible when Purge Protection
+// is enabled on the Key Vault (in which case only the Key Vault service can purge the item once the retention period expires)
+func keyVaultRecoveryLevelIsPurgeable(recoveryLevel string) bool {
+	return strings.Contains(strings.ToLower(recoveryLevel), "purgeable")
+}
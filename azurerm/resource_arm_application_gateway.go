@@ -14,6 +14,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var applicationGatewayResourceName = "azurerm_application_gateway"
+
 func resourceArmApplicationGateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmApplicationGatewayCreateUpdate,
@@ -475,6 +477,7 @@ func resourceArmApplicationGateway() *schema.Resource {
 			"probe": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -746,6 +749,9 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	tags := d.Get("tags").(map[string]interface{})
 
+	azureRMLockByName(name, applicationGatewayResourceName)
+	defer azureRMUnlockByName(name, applicationGatewayResourceName)
+
 	// Gateway ID is needed to link sub-resources together in expand functions
 	gatewayIDFmt := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s"
 	gatewayID := fmt.Sprintf(gatewayIDFmt, armClient.subscriptionId, resGroup, name)
@@ -929,6 +935,9 @@ func resourceArmApplicationGatewayDelete(d *schema.ResourceData, meta interface{
 	resGroup := id.ResourceGroup
 	name := id.Path["applicationGateways"]
 
+	azureRMLockByName(name, applicationGatewayResourceName)
+	defer azureRMUnlockByName(name, applicationGatewayResourceName)
+
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error deleting for Application Gateway %q (Resource Group %q): %+v", name, resGroup, err)
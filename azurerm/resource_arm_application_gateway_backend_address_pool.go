@@ -0,0 +1,247 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationGatewayBackendAddressPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationGatewayBackendAddressPoolCreateUpdate,
+		Read:   resourceArmApplicationGatewayBackendAddressPoolRead,
+		Update: resourceArmApplicationGatewayBackendAddressPoolCreateUpdate,
+		Delete: resourceArmApplicationGatewayBackendAddressPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"application_gateway_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"fqdns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.IPv4Address,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmApplicationGatewayBackendAddressPoolCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationGatewayClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	gatewayName := d.Get("application_gateway_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	azureRMLockByName(gatewayName, applicationGatewayResourceName)
+	defer azureRMUnlockByName(gatewayName, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+
+	if gateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): `properties` was nil", gatewayName, resourceGroup)
+	}
+
+	pools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+	if existing := gateway.ApplicationGatewayPropertiesFormat.BackendAddressPools; existing != nil {
+		pools = *existing
+	}
+
+	existingPool, index, exists := findApplicationGatewayBackendAddressPoolByName(&gateway, name)
+	if exists && requireResourcesToBeImported && d.IsNewResource() && existingPool.ID != nil {
+		return tf.ImportAsExistsError("azurerm_application_gateway_backend_address_pool", *existingPool.ID)
+	}
+
+	pool := expandApplicationGatewayBackendAddressPool(d)
+	if exists {
+		pools[index] = pool
+	} else {
+		pools = append(pools, pool)
+	}
+	gateway.ApplicationGatewayPropertiesFormat.BackendAddressPools = &pools
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, gatewayName, gateway)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Backend Address Pool %q (Application Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Backend Address Pool %q (Application Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+
+	created, _, exists := findApplicationGatewayBackendAddressPoolByName(&read, name)
+	if !exists || created.ID == nil {
+		return fmt.Errorf("Cannot find ID for Backend Address Pool %q (Application Gateway %q / Resource Group %q)", name, gatewayName, resourceGroup)
+	}
+	d.SetId(*created.ID)
+
+	return resourceArmApplicationGatewayBackendAddressPoolRead(d, meta)
+}
+
+func resourceArmApplicationGatewayBackendAddressPoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationGatewayClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	gatewayName := id.Path["applicationGateways"]
+	name := id.Path["backendAddressPools"]
+
+	gateway, err := client.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] Application Gateway %q (Resource Group %q) was not found - removing from state!", gatewayName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+
+	pool, _, exists := findApplicationGatewayBackendAddressPoolByName(&gateway, name)
+	if !exists {
+		log.Printf("[DEBUG] Backend Address Pool %q was not found on Application Gateway %q (Resource Group %q) - removing from state!", name, gatewayName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", pool.Name)
+	d.Set("application_gateway_name", gatewayName)
+	d.Set("resource_group_name", resourceGroup)
+
+	fqdns := make([]interface{}, 0)
+	ipAddresses := make([]interface{}, 0)
+	if props := pool.ApplicationGatewayBackendAddressPoolPropertiesFormat; props != nil {
+		if addresses := props.BackendAddresses; addresses != nil {
+			for _, address := range *addresses {
+				if address.IPAddress != nil {
+					ipAddresses = append(ipAddresses, *address.IPAddress)
+				} else if address.Fqdn != nil {
+					fqdns = append(fqdns, *address.Fqdn)
+				}
+			}
+		}
+	}
+
+	if err := d.Set("fqdns", fqdns); err != nil {
+		return fmt.Errorf("Error setting `fqdns`: %+v", err)
+	}
+
+	if err := d.Set("ip_addresses", ipAddresses); err != nil {
+		return fmt.Errorf("Error setting `ip_addresses`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmApplicationGatewayBackendAddressPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationGatewayClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	gatewayName := id.Path["applicationGateways"]
+	name := id.Path["backendAddressPools"]
+
+	azureRMLockByName(gatewayName, applicationGatewayResourceName)
+	defer azureRMUnlockByName(gatewayName, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			// assume deleted
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+
+	_, index, exists := findApplicationGatewayBackendAddressPoolByName(&gateway, name)
+	if !exists {
+		return nil
+	}
+
+	pools := *gateway.ApplicationGatewayPropertiesFormat.BackendAddressPools
+	pools = append(pools[:index], pools[index+1:]...)
+	gateway.ApplicationGatewayPropertiesFormat.BackendAddressPools = &pools
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, gatewayName, gateway)
+	if err != nil {
+		return fmt.Errorf("Error deleting Backend Address Pool %q from Application Gateway %q (Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Backend Address Pool %q from Application Gateway %q (Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandApplicationGatewayBackendAddressPool(d *schema.ResourceData) network.ApplicationGatewayBackendAddressPool {
+	backendAddresses := make([]network.ApplicationGatewayBackendAddress, 0)
+
+	for _, ip := range d.Get("ip_addresses").([]interface{}) {
+		backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+			IPAddress: utils.String(ip.(string)),
+		})
+	}
+
+	for _, fqdn := range d.Get("fqdns").([]interface{}) {
+		backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+			Fqdn: utils.String(fqdn.(string)),
+		})
+	}
+
+	return network.ApplicationGatewayBackendAddressPool{
+		Name: utils.String(d.Get("name").(string)),
+		ApplicationGatewayBackendAddressPoolPropertiesFormat: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+			BackendAddresses: &backendAddresses,
+		},
+	}
+}
@@ -0,0 +1,167 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApplicationGatewayBackendAddressPool_basic(t *testing.T) {
+	resourceName := "azurerm_application_gateway_backend_address_pool.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayBackendAddressPool_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayBackendAddressPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "acctest-pool"),
+					resource.TestCheckResourceAttr(resourceName, "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "fqdns.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGatewayBackendAddressPool_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_application_gateway_backend_address_pool.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayBackendAddressPool_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayBackendAddressPoolExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMApplicationGatewayBackendAddressPool_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_application_gateway_backend_address_pool"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGatewayBackendAddressPool_update(t *testing.T) {
+	resourceName := "azurerm_application_gateway_backend_address_pool.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayBackendAddressPool_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayBackendAddressPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "fqdns.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMApplicationGatewayBackendAddressPool_fqdns(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayBackendAddressPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_addresses.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "fqdns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fqdns.0", "example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApplicationGatewayBackendAddressPoolExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		gatewayName := rs.Primary.Attributes["application_gateway_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).applicationGatewayClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		gateway, err := client.Get(ctx, resourceGroup, gatewayName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on applicationGatewayClient: %+v", err)
+		}
+
+		if _, _, exists := findApplicationGatewayBackendAddressPoolByName(&gateway, name); !exists {
+			return fmt.Errorf("Bad: Backend Address Pool %q (Application Gateway %q / Resource Group %q) does not exist", name, gatewayName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMApplicationGatewayBackendAddressPool_basic(rInt int, location string) string {
+	template := testAccAzureRMApplicationGateway_subResourceTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool" "test" {
+  name                     = "acctest-pool"
+  application_gateway_name = "${azurerm_application_gateway.test.name}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  ip_addresses             = ["10.0.1.4", "10.0.1.5"]
+}
+`, template)
+}
+
+func testAccAzureRMApplicationGatewayBackendAddressPool_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMApplicationGatewayBackendAddressPool_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool" "import" {
+  name                     = "${azurerm_application_gateway_backend_address_pool.test.name}"
+  application_gateway_name = "${azurerm_application_gateway_backend_address_pool.test.application_gateway_name}"
+  resource_group_name      = "${azurerm_application_gateway_backend_address_pool.test.resource_group_name}"
+  ip_addresses             = ["10.0.1.4", "10.0.1.5"]
+}
+`, template)
+}
+
+func testAccAzureRMApplicationGatewayBackendAddressPool_fqdns(rInt int, location string) string {
+	template := testAccAzureRMApplicationGateway_subResourceTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool" "test" {
+  name                     = "acctest-pool"
+  application_gateway_name = "${azurerm_application_gateway.test.name}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  fqdns                    = ["example.com"]
+}
+`, template)
+}
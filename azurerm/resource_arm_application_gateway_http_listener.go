@@ -0,0 +1,307 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationGatewayHTTPListener() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationGatewayHTTPListenerCreateUpdate,
+		Read:   resourceArmApplicationGatewayHTTPListenerRead,
+		Update: resourceArmApplicationGatewayHTTPListenerCreateUpdate,
+		Delete: resourceArmApplicationGatewayHTTPListenerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"application_gateway_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"frontend_ip_configuration_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"frontend_port_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"protocol": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.HTTP),
+					string(network.HTTPS),
+				}, true),
+			},
+
+			"host_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ssl_certificate_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"require_sni": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"frontend_ip_configuration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"frontend_port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ssl_certificate_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmApplicationGatewayHTTPListenerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationGatewayClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	gatewayName := d.Get("application_gateway_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	azureRMLockByName(gatewayName, applicationGatewayResourceName)
+	defer azureRMUnlockByName(gatewayName, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+
+	if gateway.ID == nil || gateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): `id` or `properties` was nil", gatewayName, resourceGroup)
+	}
+
+	listeners := make([]network.ApplicationGatewayHTTPListener, 0)
+	if existing := gateway.ApplicationGatewayPropertiesFormat.HTTPListeners; existing != nil {
+		listeners = *existing
+	}
+
+	existingListener, index, exists := findApplicationGatewayHTTPListenerByName(&gateway, name)
+	if exists && requireResourcesToBeImported && d.IsNewResource() && existingListener.ID != nil {
+		return tf.ImportAsExistsError("azurerm_application_gateway_http_listener", *existingListener.ID)
+	}
+
+	listener := expandApplicationGatewayHTTPListener(d, *gateway.ID)
+	if exists {
+		listeners[index] = listener
+	} else {
+		listeners = append(listeners, listener)
+	}
+	gateway.ApplicationGatewayPropertiesFormat.HTTPListeners = &listeners
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, gatewayName, gateway)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating HTTP Listener %q (Application Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of HTTP Listener %q (Application Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+
+	created, _, exists := findApplicationGatewayHTTPListenerByName(&read, name)
+	if !exists || created.ID == nil {
+		return fmt.Errorf("Cannot find ID for HTTP Listener %q (Application Gateway %q / Resource Group %q)", name, gatewayName, resourceGroup)
+	}
+	d.SetId(*created.ID)
+
+	return resourceArmApplicationGatewayHTTPListenerRead(d, meta)
+}
+
+func resourceArmApplicationGatewayHTTPListenerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationGatewayClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	gatewayName := id.Path["applicationGateways"]
+	name := id.Path["httpListeners"]
+
+	gateway, err := client.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] Application Gateway %q (Resource Group %q) was not found - removing from state!", gatewayName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+
+	listener, _, exists := findApplicationGatewayHTTPListenerByName(&gateway, name)
+	if !exists {
+		log.Printf("[DEBUG] HTTP Listener %q was not found on Application Gateway %q (Resource Group %q) - removing from state!", name, gatewayName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", listener.Name)
+	d.Set("application_gateway_name", gatewayName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := listener.ApplicationGatewayHTTPListenerPropertiesFormat; props != nil {
+		d.Set("protocol", string(props.Protocol))
+		d.Set("host_name", props.HostName)
+		d.Set("require_sni", props.RequireServerNameIndication)
+
+		if feConfig := props.FrontendIPConfiguration; feConfig != nil && feConfig.ID != nil {
+			feConfigId, err := parseAzureResourceID(*feConfig.ID)
+			if err != nil {
+				return err
+			}
+			d.Set("frontend_ip_configuration_name", feConfigId.Path["frontendIPConfigurations"])
+			d.Set("frontend_ip_configuration_id", feConfig.ID)
+		}
+
+		if port := props.FrontendPort; port != nil && port.ID != nil {
+			portId, err := parseAzureResourceID(*port.ID)
+			if err != nil {
+				return err
+			}
+			d.Set("frontend_port_name", portId.Path["frontendPorts"])
+			d.Set("frontend_port_id", port.ID)
+		}
+
+		sslCertificateName := ""
+		sslCertificateId := ""
+		if cert := props.SslCertificate; cert != nil && cert.ID != nil {
+			certId, err := parseAzureResourceID(*cert.ID)
+			if err != nil {
+				return err
+			}
+			sslCertificateName = certId.Path["sslCertificates"]
+			sslCertificateId = *cert.ID
+		}
+		d.Set("ssl_certificate_name", sslCertificateName)
+		d.Set("ssl_certificate_id", sslCertificateId)
+	}
+
+	return nil
+}
+
+func resourceArmApplicationGatewayHTTPListenerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationGatewayClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	gatewayName := id.Path["applicationGateways"]
+	name := id.Path["httpListeners"]
+
+	azureRMLockByName(gatewayName, applicationGatewayResourceName)
+	defer azureRMUnlockByName(gatewayName, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			// assume deleted
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+
+	_, index, exists := findApplicationGatewayHTTPListenerByName(&gateway, name)
+	if !exists {
+		return nil
+	}
+
+	listeners := *gateway.ApplicationGatewayPropertiesFormat.HTTPListeners
+	listeners = append(listeners[:index], listeners[index+1:]...)
+	gateway.ApplicationGatewayPropertiesFormat.HTTPListeners = &listeners
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, gatewayName, gateway)
+	if err != nil {
+		return fmt.Errorf("Error deleting HTTP Listener %q from Application Gateway %q (Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of HTTP Listener %q from Application Gateway %q (Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandApplicationGatewayHTTPListener(d *schema.ResourceData, gatewayID string) network.ApplicationGatewayHTTPListener {
+	frontendIPConfigID := fmt.Sprintf("%s/frontendIPConfigurations/%s", gatewayID, d.Get("frontend_ip_configuration_name").(string))
+	frontendPortID := fmt.Sprintf("%s/frontendPorts/%s", gatewayID, d.Get("frontend_port_name").(string))
+
+	listener := network.ApplicationGatewayHTTPListener{
+		Name: utils.String(d.Get("name").(string)),
+		ApplicationGatewayHTTPListenerPropertiesFormat: &network.ApplicationGatewayHTTPListenerPropertiesFormat{
+			FrontendIPConfiguration: &network.SubResource{
+				ID: utils.String(frontendIPConfigID),
+			},
+			FrontendPort: &network.SubResource{
+				ID: utils.String(frontendPortID),
+			},
+			Protocol:                    network.ApplicationGatewayProtocol(d.Get("protocol").(string)),
+			RequireServerNameIndication: utils.Bool(d.Get("require_sni").(bool)),
+		},
+	}
+
+	if host := d.Get("host_name").(string); host != "" {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostName = utils.String(host)
+	}
+
+	if sslCertName := d.Get("ssl_certificate_name").(string); sslCertName != "" {
+		certID := fmt.Sprintf("%s/sslCertificates/%s", gatewayID, sslCertName)
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslCertificate = &network.SubResource{
+			ID: utils.String(certID),
+		}
+	}
+
+	return listener
+}
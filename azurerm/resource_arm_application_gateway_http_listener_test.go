@@ -0,0 +1,172 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApplicationGatewayHTTPListener_basic(t *testing.T) {
+	resourceName := "azurerm_application_gateway_http_listener.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayHTTPListener_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayHTTPListenerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "acctest-listener"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "Http"),
+					resource.TestCheckResourceAttrSet(resourceName, "frontend_ip_configuration_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "frontend_port_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGatewayHTTPListener_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_application_gateway_http_listener.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayHTTPListener_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayHTTPListenerExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMApplicationGatewayHTTPListener_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_application_gateway_http_listener"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGatewayHTTPListener_hostName(t *testing.T) {
+	resourceName := "azurerm_application_gateway_http_listener.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayHTTPListener_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayHTTPListenerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "host_name", ""),
+				),
+			},
+			{
+				Config: testAccAzureRMApplicationGatewayHTTPListener_hostName(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayHTTPListenerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "host_name", "www.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApplicationGatewayHTTPListenerExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		gatewayName := rs.Primary.Attributes["application_gateway_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).applicationGatewayClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		gateway, err := client.Get(ctx, resourceGroup, gatewayName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on applicationGatewayClient: %+v", err)
+		}
+
+		if _, _, exists := findApplicationGatewayHTTPListenerByName(&gateway, name); !exists {
+			return fmt.Errorf("Bad: HTTP Listener %q (Application Gateway %q / Resource Group %q) does not exist", name, gatewayName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMApplicationGatewayHTTPListener_basic(rInt int, location string) string {
+	template := testAccAzureRMApplicationGateway_subResourceTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "acctest-listener"
+  application_gateway_name       = "${azurerm_application_gateway.test.name}"
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  frontend_ip_configuration_name = "${local.frontend_ip_configuration_name}"
+  frontend_port_name             = "${local.frontend_port_name}-alt"
+  protocol                       = "Http"
+}
+`, template)
+}
+
+func testAccAzureRMApplicationGatewayHTTPListener_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMApplicationGatewayHTTPListener_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "import" {
+  name                           = "${azurerm_application_gateway_http_listener.test.name}"
+  application_gateway_name       = "${azurerm_application_gateway_http_listener.test.application_gateway_name}"
+  resource_group_name            = "${azurerm_application_gateway_http_listener.test.resource_group_name}"
+  frontend_ip_configuration_name = "${azurerm_application_gateway_http_listener.test.frontend_ip_configuration_name}"
+  frontend_port_name             = "${azurerm_application_gateway_http_listener.test.frontend_port_name}"
+  protocol                       = "Http"
+}
+`, template)
+}
+
+func testAccAzureRMApplicationGatewayHTTPListener_hostName(rInt int, location string) string {
+	template := testAccAzureRMApplicationGateway_subResourceTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "acctest-listener"
+  application_gateway_name       = "${azurerm_application_gateway.test.name}"
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  frontend_ip_configuration_name = "${local.frontend_ip_configuration_name}"
+  frontend_port_name             = "${local.frontend_port_name}-alt"
+  protocol                       = "Http"
+  host_name                      = "www.example.com"
+}
+`, template)
+}
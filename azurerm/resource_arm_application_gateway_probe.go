@@ -0,0 +1,323 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationGatewayProbe() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationGatewayProbeCreateUpdate,
+		Read:   resourceArmApplicationGatewayProbeRead,
+		Update: resourceArmApplicationGatewayProbeCreateUpdate,
+		Delete: resourceArmApplicationGatewayProbeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"application_gateway_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"protocol": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.HTTP),
+					string(network.HTTPS),
+				}, true),
+			},
+
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"host": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"interval": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 86400),
+			},
+
+			"timeout": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 86400),
+			},
+
+			"unhealthy_threshold": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+
+			"minimum_servers": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+
+			"match": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"body": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "*",
+						},
+
+						"status_code": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmApplicationGatewayProbeCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationGatewayClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	gatewayName := d.Get("application_gateway_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	azureRMLockByName(gatewayName, applicationGatewayResourceName)
+	defer azureRMUnlockByName(gatewayName, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+
+	if gateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): `properties` was nil", gatewayName, resourceGroup)
+	}
+
+	probes := make([]network.ApplicationGatewayProbe, 0)
+	if existing := gateway.ApplicationGatewayPropertiesFormat.Probes; existing != nil {
+		probes = *existing
+	}
+
+	existingProbe, index, exists := findApplicationGatewayProbeByName(&gateway, name)
+	if exists && requireResourcesToBeImported && d.IsNewResource() && existingProbe.ID != nil {
+		return tf.ImportAsExistsError("azurerm_application_gateway_probe", *existingProbe.ID)
+	}
+
+	probe := expandApplicationGatewayProbe(d)
+	if exists {
+		probes[index] = probe
+	} else {
+		probes = append(probes, probe)
+	}
+	gateway.ApplicationGatewayPropertiesFormat.Probes = &probes
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, gatewayName, gateway)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Probe %q (Application Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Probe %q (Application Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+
+	created, _, exists := findApplicationGatewayProbeByName(&read, name)
+	if !exists || created.ID == nil {
+		return fmt.Errorf("Cannot find ID for Probe %q (Application Gateway %q / Resource Group %q)", name, gatewayName, resourceGroup)
+	}
+	d.SetId(*created.ID)
+
+	return resourceArmApplicationGatewayProbeRead(d, meta)
+}
+
+func resourceArmApplicationGatewayProbeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationGatewayClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	gatewayName := id.Path["applicationGateways"]
+	name := id.Path["probes"]
+
+	gateway, err := client.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] Application Gateway %q (Resource Group %q) was not found - removing from state!", gatewayName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+
+	probe, _, exists := findApplicationGatewayProbeByName(&gateway, name)
+	if !exists {
+		log.Printf("[DEBUG] Probe %q was not found on Application Gateway %q (Resource Group %q) - removing from state!", name, gatewayName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", probe.Name)
+	d.Set("application_gateway_name", gatewayName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := probe.ApplicationGatewayProbePropertiesFormat; props != nil {
+		d.Set("protocol", string(props.Protocol))
+		d.Set("host", props.Host)
+		d.Set("path", props.Path)
+		d.Set("interval", props.Interval)
+		d.Set("timeout", props.Timeout)
+		d.Set("unhealthy_threshold", props.UnhealthyThreshold)
+		d.Set("minimum_servers", props.MinServers)
+
+		if err := d.Set("match", flattenApplicationGatewayProbeMatch(props.Match)); err != nil {
+			return fmt.Errorf("Error setting `match`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmApplicationGatewayProbeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationGatewayClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	gatewayName := id.Path["applicationGateways"]
+	name := id.Path["probes"]
+
+	azureRMLockByName(gatewayName, applicationGatewayResourceName)
+	defer azureRMUnlockByName(gatewayName, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			// assume deleted
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+
+	_, index, exists := findApplicationGatewayProbeByName(&gateway, name)
+	if !exists {
+		return nil
+	}
+
+	probes := *gateway.ApplicationGatewayPropertiesFormat.Probes
+	probes = append(probes[:index], probes[index+1:]...)
+	gateway.ApplicationGatewayPropertiesFormat.Probes = &probes
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, gatewayName, gateway)
+	if err != nil {
+		return fmt.Errorf("Error deleting Probe %q from Application Gateway %q (Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Probe %q from Application Gateway %q (Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandApplicationGatewayProbe(d *schema.ResourceData) network.ApplicationGatewayProbe {
+	probe := network.ApplicationGatewayProbe{
+		Name: utils.String(d.Get("name").(string)),
+		ApplicationGatewayProbePropertiesFormat: &network.ApplicationGatewayProbePropertiesFormat{
+			Host:               utils.String(d.Get("host").(string)),
+			Interval:           utils.Int32(int32(d.Get("interval").(int))),
+			MinServers:         utils.Int32(int32(d.Get("minimum_servers").(int))),
+			Path:               utils.String(d.Get("path").(string)),
+			Protocol:           network.ApplicationGatewayProtocol(d.Get("protocol").(string)),
+			Timeout:            utils.Int32(int32(d.Get("timeout").(int))),
+			UnhealthyThreshold: utils.Int32(int32(d.Get("unhealthy_threshold").(int))),
+		},
+	}
+
+	matchConfigs := d.Get("match").([]interface{})
+	if len(matchConfigs) > 0 && matchConfigs[0] != nil {
+		match := matchConfigs[0].(map[string]interface{})
+
+		statusCodes := make([]string, 0)
+		for _, statusCode := range match["status_code"].([]interface{}) {
+			statusCodes = append(statusCodes, statusCode.(string))
+		}
+
+		probe.ApplicationGatewayProbePropertiesFormat.Match = &network.ApplicationGatewayProbeHealthResponseMatch{
+			Body:        utils.String(match["body"].(string)),
+			StatusCodes: &statusCodes,
+		}
+	}
+
+	return probe
+}
+
+func flattenApplicationGatewayProbeMatch(input *network.ApplicationGatewayProbeHealthResponseMatch) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{}
+	if body := input.Body; body != nil {
+		output["body"] = *body
+	}
+
+	statusCodes := make([]interface{}, 0)
+	if input.StatusCodes != nil {
+		for _, status := range *input.StatusCodes {
+			statusCodes = append(statusCodes, status)
+		}
+	}
+	output["status_code"] = statusCodes
+
+	return []interface{}{output}
+}
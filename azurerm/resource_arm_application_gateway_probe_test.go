@@ -0,0 +1,186 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApplicationGatewayProbe_basic(t *testing.T) {
+	resourceName := "azurerm_application_gateway_probe.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayProbe_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayProbeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "acctest-probe"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "Http"),
+					resource.TestCheckResourceAttr(resourceName, "interval", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGatewayProbe_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_application_gateway_probe.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayProbe_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayProbeExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMApplicationGatewayProbe_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_application_gateway_probe"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGatewayProbe_match(t *testing.T) {
+	resourceName := "azurerm_application_gateway_probe.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayProbe_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayProbeExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMApplicationGatewayProbe_match(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayProbeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "interval", "60"),
+					resource.TestCheckResourceAttr(resourceName, "match.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "match.0.body", "healthy"),
+					resource.TestCheckResourceAttr(resourceName, "match.0.status_code.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApplicationGatewayProbeExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		gatewayName := rs.Primary.Attributes["application_gateway_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).applicationGatewayClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		gateway, err := client.Get(ctx, resourceGroup, gatewayName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on applicationGatewayClient: %+v", err)
+		}
+
+		if _, _, exists := findApplicationGatewayProbeByName(&gateway, name); !exists {
+			return fmt.Errorf("Bad: Probe %q (Application Gateway %q / Resource Group %q) does not exist", name, gatewayName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMApplicationGatewayProbe_basic(rInt int, location string) string {
+	template := testAccAzureRMApplicationGateway_subResourceTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_probe" "test" {
+  name                     = "acctest-probe"
+  application_gateway_name = "${azurerm_application_gateway.test.name}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  protocol                 = "Http"
+  path                     = "/health"
+  host                     = "www.example.com"
+  interval                 = 30
+  timeout                  = 30
+  unhealthy_threshold      = 3
+}
+`, template)
+}
+
+func testAccAzureRMApplicationGatewayProbe_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMApplicationGatewayProbe_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_probe" "import" {
+  name                     = "${azurerm_application_gateway_probe.test.name}"
+  application_gateway_name = "${azurerm_application_gateway_probe.test.application_gateway_name}"
+  resource_group_name      = "${azurerm_application_gateway_probe.test.resource_group_name}"
+  protocol                 = "Http"
+  path                     = "/health"
+  host                     = "www.example.com"
+  interval                 = 30
+  timeout                  = 30
+  unhealthy_threshold      = 3
+}
+`, template)
+}
+
+func testAccAzureRMApplicationGatewayProbe_match(rInt int, location string) string {
+	template := testAccAzureRMApplicationGateway_subResourceTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_probe" "test" {
+  name                     = "acctest-probe"
+  application_gateway_name = "${azurerm_application_gateway.test.name}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  protocol                 = "Http"
+  path                     = "/health"
+  host                     = "www.example.com"
+  interval                 = 60
+  timeout                  = 30
+  unhealthy_threshold      = 3
+
+  match {
+    body        = "healthy"
+    status_code = ["200-399"]
+  }
+}
+`, template)
+}
@@ -0,0 +1,326 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationGatewayRequestRoutingRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationGatewayRequestRoutingRuleCreateUpdate,
+		Read:   resourceArmApplicationGatewayRequestRoutingRuleRead,
+		Update: resourceArmApplicationGatewayRequestRoutingRuleCreateUpdate,
+		Delete: resourceArmApplicationGatewayRequestRoutingRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"application_gateway_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"rule_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Basic),
+					string(network.PathBasedRouting),
+				}, true),
+			},
+
+			"http_listener_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"backend_address_pool_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"backend_http_settings_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"url_path_map_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"backend_address_pool_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"backend_http_settings_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"http_listener_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"url_path_map_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmApplicationGatewayRequestRoutingRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationGatewayClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	gatewayName := d.Get("application_gateway_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	azureRMLockByName(gatewayName, applicationGatewayResourceName)
+	defer azureRMUnlockByName(gatewayName, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+
+	if gateway.ID == nil || gateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): `id` or `properties` was nil", gatewayName, resourceGroup)
+	}
+
+	rules := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+	if existing := gateway.ApplicationGatewayPropertiesFormat.RequestRoutingRules; existing != nil {
+		rules = *existing
+	}
+
+	existingRule, index, exists := findApplicationGatewayRequestRoutingRuleByName(&gateway, name)
+	if exists && requireResourcesToBeImported && d.IsNewResource() && existingRule.ID != nil {
+		return tf.ImportAsExistsError("azurerm_application_gateway_request_routing_rule", *existingRule.ID)
+	}
+
+	rule := expandApplicationGatewayRequestRoutingRule(d, *gateway.ID)
+	if exists {
+		rules[index] = rule
+	} else {
+		rules = append(rules, rule)
+	}
+	gateway.ApplicationGatewayPropertiesFormat.RequestRoutingRules = &rules
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, gatewayName, gateway)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Request Routing Rule %q (Application Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Request Routing Rule %q (Application Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+
+	created, _, exists := findApplicationGatewayRequestRoutingRuleByName(&read, name)
+	if !exists || created.ID == nil {
+		return fmt.Errorf("Cannot find ID for Request Routing Rule %q (Application Gateway %q / Resource Group %q)", name, gatewayName, resourceGroup)
+	}
+	d.SetId(*created.ID)
+
+	return resourceArmApplicationGatewayRequestRoutingRuleRead(d, meta)
+}
+
+func resourceArmApplicationGatewayRequestRoutingRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationGatewayClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	gatewayName := id.Path["applicationGateways"]
+	name := id.Path["requestRoutingRules"]
+
+	gateway, err := client.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] Application Gateway %q (Resource Group %q) was not found - removing from state!", gatewayName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+
+	rule, _, exists := findApplicationGatewayRequestRoutingRuleByName(&gateway, name)
+	if !exists {
+		log.Printf("[DEBUG] Request Routing Rule %q was not found on Application Gateway %q (Resource Group %q) - removing from state!", name, gatewayName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("application_gateway_name", gatewayName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := rule.ApplicationGatewayRequestRoutingRulePropertiesFormat; props != nil {
+		d.Set("rule_type", string(props.RuleType))
+
+		if listener := props.HTTPListener; listener != nil && listener.ID != nil {
+			listenerId, err := parseAzureResourceID(*listener.ID)
+			if err != nil {
+				return err
+			}
+			d.Set("http_listener_name", listenerId.Path["httpListeners"])
+			d.Set("http_listener_id", listener.ID)
+		}
+
+		backendAddressPoolName := ""
+		backendAddressPoolId := ""
+		if pool := props.BackendAddressPool; pool != nil && pool.ID != nil {
+			poolId, err := parseAzureResourceID(*pool.ID)
+			if err != nil {
+				return err
+			}
+			backendAddressPoolName = poolId.Path["backendAddressPools"]
+			backendAddressPoolId = *pool.ID
+		}
+		d.Set("backend_address_pool_name", backendAddressPoolName)
+		d.Set("backend_address_pool_id", backendAddressPoolId)
+
+		backendHTTPSettingsName := ""
+		backendHTTPSettingsId := ""
+		if settings := props.BackendHTTPSettings; settings != nil && settings.ID != nil {
+			settingsId, err := parseAzureResourceID(*settings.ID)
+			if err != nil {
+				return err
+			}
+			backendHTTPSettingsName = settingsId.Path["backendHttpSettingsCollection"]
+			backendHTTPSettingsId = *settings.ID
+		}
+		d.Set("backend_http_settings_name", backendHTTPSettingsName)
+		d.Set("backend_http_settings_id", backendHTTPSettingsId)
+
+		urlPathMapName := ""
+		urlPathMapId := ""
+		if pathMap := props.URLPathMap; pathMap != nil && pathMap.ID != nil {
+			pathMapId, err := parseAzureResourceID(*pathMap.ID)
+			if err != nil {
+				return err
+			}
+			urlPathMapName = pathMapId.Path["urlPathMaps"]
+			urlPathMapId = *pathMap.ID
+		}
+		d.Set("url_path_map_name", urlPathMapName)
+		d.Set("url_path_map_id", urlPathMapId)
+	}
+
+	return nil
+}
+
+func resourceArmApplicationGatewayRequestRoutingRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationGatewayClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	gatewayName := id.Path["applicationGateways"]
+	name := id.Path["requestRoutingRules"]
+
+	azureRMLockByName(gatewayName, applicationGatewayResourceName)
+	defer azureRMUnlockByName(gatewayName, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, resourceGroup, gatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			// assume deleted
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", gatewayName, resourceGroup, err)
+	}
+
+	_, index, exists := findApplicationGatewayRequestRoutingRuleByName(&gateway, name)
+	if !exists {
+		return nil
+	}
+
+	rules := *gateway.ApplicationGatewayPropertiesFormat.RequestRoutingRules
+	rules = append(rules[:index], rules[index+1:]...)
+	gateway.ApplicationGatewayPropertiesFormat.RequestRoutingRules = &rules
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, gatewayName, gateway)
+	if err != nil {
+		return fmt.Errorf("Error deleting Request Routing Rule %q from Application Gateway %q (Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Request Routing Rule %q from Application Gateway %q (Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandApplicationGatewayRequestRoutingRule(d *schema.ResourceData, gatewayID string) network.ApplicationGatewayRequestRoutingRule {
+	httpListenerID := fmt.Sprintf("%s/httpListeners/%s", gatewayID, d.Get("http_listener_name").(string))
+
+	rule := network.ApplicationGatewayRequestRoutingRule{
+		Name: utils.String(d.Get("name").(string)),
+		ApplicationGatewayRequestRoutingRulePropertiesFormat: &network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+			RuleType: network.ApplicationGatewayRequestRoutingRuleType(d.Get("rule_type").(string)),
+			HTTPListener: &network.SubResource{
+				ID: utils.String(httpListenerID),
+			},
+		},
+	}
+
+	if backendAddressPoolName := d.Get("backend_address_pool_name").(string); backendAddressPoolName != "" {
+		backendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, backendAddressPoolName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendAddressPool = &network.SubResource{
+			ID: utils.String(backendAddressPoolID),
+		}
+	}
+
+	if backendHTTPSettingsName := d.Get("backend_http_settings_name").(string); backendHTTPSettingsName != "" {
+		backendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, backendHTTPSettingsName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendHTTPSettings = &network.SubResource{
+			ID: utils.String(backendHTTPSettingsID),
+		}
+	}
+
+	if urlPathMapName := d.Get("url_path_map_name").(string); urlPathMapName != "" {
+		urlPathMapID := fmt.Sprintf("%s/urlPathMaps/%s", gatewayID, urlPathMapName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.URLPathMap = &network.SubResource{
+			ID: utils.String(urlPathMapID),
+		}
+	}
+
+	return rule
+}
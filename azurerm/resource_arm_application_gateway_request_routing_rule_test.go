@@ -0,0 +1,146 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApplicationGatewayRequestRoutingRule_basic(t *testing.T) {
+	resourceName := "azurerm_application_gateway_request_routing_rule.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayRequestRoutingRule_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayRequestRoutingRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "acctest-rule"),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "Basic"),
+					resource.TestCheckResourceAttrSet(resourceName, "http_listener_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "backend_address_pool_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "backend_http_settings_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGatewayRequestRoutingRule_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_application_gateway_request_routing_rule.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGatewayRequestRoutingRule_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayRequestRoutingRuleExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMApplicationGatewayRequestRoutingRule_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_application_gateway_request_routing_rule"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApplicationGatewayRequestRoutingRuleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		gatewayName := rs.Primary.Attributes["application_gateway_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).applicationGatewayClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		gateway, err := client.Get(ctx, resourceGroup, gatewayName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on applicationGatewayClient: %+v", err)
+		}
+
+		if _, _, exists := findApplicationGatewayRequestRoutingRuleByName(&gateway, name); !exists {
+			return fmt.Errorf("Bad: Request Routing Rule %q (Application Gateway %q / Resource Group %q) does not exist", name, gatewayName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMApplicationGatewayRequestRoutingRule_basic(rInt int, location string) string {
+	template := testAccAzureRMApplicationGateway_subResourceTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool" "test" {
+  name                     = "acctest-pool"
+  application_gateway_name = "${azurerm_application_gateway.test.name}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  ip_addresses             = ["10.0.1.4"]
+}
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "acctest-listener"
+  application_gateway_name       = "${azurerm_application_gateway.test.name}"
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  frontend_ip_configuration_name = "${local.frontend_ip_configuration_name}"
+  frontend_port_name             = "${local.frontend_port_name}-alt"
+  protocol                       = "Http"
+}
+
+resource "azurerm_application_gateway_request_routing_rule" "test" {
+  name                       = "acctest-rule"
+  application_gateway_name   = "${azurerm_application_gateway.test.name}"
+  resource_group_name        = "${azurerm_resource_group.test.name}"
+  rule_type                  = "Basic"
+  http_listener_name         = "${azurerm_application_gateway_http_listener.test.name}"
+  backend_address_pool_name  = "${azurerm_application_gateway_backend_address_pool.test.name}"
+  backend_http_settings_name = "${local.http_setting_name}"
+}
+`, template)
+}
+
+func testAccAzureRMApplicationGatewayRequestRoutingRule_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMApplicationGatewayRequestRoutingRule_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_request_routing_rule" "import" {
+  name                       = "${azurerm_application_gateway_request_routing_rule.test.name}"
+  application_gateway_name   = "${azurerm_application_gateway_request_routing_rule.test.application_gateway_name}"
+  resource_group_name        = "${azurerm_application_gateway_request_routing_rule.test.resource_group_name}"
+  rule_type                  = "Basic"
+  http_listener_name         = "${azurerm_application_gateway_request_routing_rule.test.http_listener_name}"
+  backend_address_pool_name  = "${azurerm_application_gateway_request_routing_rule.test.backend_address_pool_name}"
+  backend_http_settings_name = "${azurerm_application_gateway_request_routing_rule.test.backend_http_settings_name}"
+}
+`, template)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -85,6 +86,12 @@ func resourceArmExpressRouteCircuitPeering() *schema.Resource {
 				},
 			},
 
+			"route_filter_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+			},
+
 			"azure_asn": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -142,6 +149,27 @@ func resourceArmExpressRouteCircuitPeeringCreateUpdate(d *schema.ResourceData, m
 		parameters.ExpressRouteCircuitPeeringPropertiesFormat.MicrosoftPeeringConfig = peeringConfig
 	}
 
+	routeFilterId := d.Get("route_filter_id").(string)
+	if routeFilterId != "" {
+		if !strings.EqualFold(peeringType, string(network.MicrosoftPeering)) {
+			return fmt.Errorf("`route_filter_id` can only be specified when `peering_type` is set to `MicrosoftPeering`")
+		}
+
+		parameters.ExpressRouteCircuitPeeringPropertiesFormat.RouteFilter = &network.RouteFilter{
+			ID: utils.String(routeFilterId),
+		}
+
+		// associating a Route Filter with the Peering updates the Route Filter
+		routeFilterResourceId, err := parseAzureResourceID(routeFilterId)
+		if err != nil {
+			return err
+		}
+		routeFilterName := routeFilterResourceId.Path["routeFilters"]
+
+		azureRMLockByName(routeFilterName, routeFilterResourceName)
+		defer azureRMUnlockByName(routeFilterName, routeFilterResourceName)
+	}
+
 	azureRMLockByName(circuitName, expressRouteCircuitResourceName)
 	defer azureRMUnlockByName(circuitName, expressRouteCircuitResourceName)
 
@@ -202,6 +230,12 @@ func resourceArmExpressRouteCircuitPeeringRead(d *schema.ResourceData, meta inte
 		if err := d.Set("microsoft_peering_config", config); err != nil {
 			return fmt.Errorf("Error setting `microsoft_peering_config`: %+v", err)
 		}
+
+		routeFilterId := ""
+		if filter := props.RouteFilter; filter != nil && filter.ID != nil {
+			routeFilterId = *filter.ID
+		}
+		d.Set("route_filter_id", routeFilterId)
 	}
 
 	return nil
@@ -29,7 +29,8 @@ func TestAccAzureRMExpressRouteCircuit(t *testing.T) {
 			"azurePrivatePeering": testAccAzureRMExpressRouteCircuitPeering_azurePrivatePeering,
 		},
 		"MicrosoftPeering": {
-			"microsoftPeering": testAccAzureRMExpressRouteCircuitPeering_microsoftPeering,
+			"microsoftPeering":                testAccAzureRMExpressRouteCircuitPeering_microsoftPeering,
+			"microsoftPeeringWithRouteFilter": testAccAzureRMExpressRouteCircuitPeering_microsoftPeeringWithRouteFilter,
 		},
 		"authorization": {
 			"basic":    testAccAzureRMExpressRouteCircuitAuthorization_basic,
@@ -0,0 +1,452 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmFirewallApplicationRuleCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmFirewallApplicationRuleCollectionCreateUpdate,
+		Read:   resourceArmFirewallApplicationRuleCollectionRead,
+		Update: resourceArmFirewallApplicationRuleCollectionCreateUpdate,
+		Delete: resourceArmFirewallApplicationRuleCollectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureFirewallName,
+			},
+
+			"azure_firewall_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureFirewallName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 65000),
+			},
+
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.AzureFirewallRCActionTypeAllow),
+					string(network.AzureFirewallRCActionTypeDeny),
+				}, false),
+			},
+
+			"rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_addresses": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"fqdn_tags": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"target_fqdns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"protocol": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"port": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.PortNumber,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(network.AzureFirewallApplicationRuleProtocolTypeHTTP),
+											string(network.AzureFirewallApplicationRuleProtocolTypeHTTPS),
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmFirewallApplicationRuleCollectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).azureFirewallsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	firewallName := d.Get("azure_firewall_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	azureRMLockByName(firewallName, azureFirewallResourceName)
+	defer azureRMUnlockByName(firewallName, azureFirewallResourceName)
+
+	firewall, err := client.Get(ctx, resourceGroup, firewallName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	if firewall.AzureFirewallPropertiesFormat == nil {
+		return fmt.Errorf("Error expanding Firewall %q (Resource Group %q): `properties` was nil.", firewallName, resourceGroup)
+	}
+	props := *firewall.AzureFirewallPropertiesFormat
+
+	// a Firewall without any Application Rule Collections may not return the list at all
+	ruleCollections := make([]network.AzureFirewallApplicationRuleCollection, 0)
+	if props.ApplicationRuleCollections != nil {
+		ruleCollections = *props.ApplicationRuleCollections
+	}
+
+	applicationRules, err := expandArmFirewallApplicationRules(d.Get("rule").(*schema.Set))
+	if err != nil {
+		return fmt.Errorf("Error expanding Firewall Application Rules: %+v", err)
+	}
+	priority := d.Get("priority").(int)
+	newRuleCollection := network.AzureFirewallApplicationRuleCollection{
+		Name: utils.String(name),
+		AzureFirewallApplicationRuleCollectionPropertiesFormat: &network.AzureFirewallApplicationRuleCollectionPropertiesFormat{
+			Action: &network.AzureFirewallRCAction{
+				Type: network.AzureFirewallRCActionType(d.Get("action").(string)),
+			},
+			Priority: utils.Int32(int32(priority)),
+			Rules:    &applicationRules,
+		},
+	}
+
+	if !d.IsNewResource() {
+		index := -1
+		for i, v := range ruleCollections {
+			if v.Name == nil {
+				continue
+			}
+
+			if *v.Name == name {
+				index = i
+				break
+			}
+		}
+
+		if index == -1 {
+			return fmt.Errorf("Error locating Application Rule Collection %q (Firewall %q / Resource Group %q)", name, firewallName, resourceGroup)
+		}
+
+		ruleCollections[index] = newRuleCollection
+	} else {
+		ruleCollections = append(ruleCollections, newRuleCollection)
+	}
+
+	firewall.AzureFirewallPropertiesFormat.ApplicationRuleCollections = &ruleCollections
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, firewallName, firewall)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Application Rule Collection %q in Firewall %q (Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Application Rule Collection %q of Firewall %q (Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, firewallName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	var collectionID string
+	if props := read.AzureFirewallPropertiesFormat; props != nil {
+		if collections := props.ApplicationRuleCollections; collections != nil {
+			for _, collection := range *collections {
+				if collection.Name == nil {
+					continue
+				}
+
+				if *collection.Name == name {
+					collectionID = *collection.ID
+					break
+				}
+			}
+		}
+	}
+
+	if collectionID == "" {
+		return fmt.Errorf("Cannot find ID for Application Rule Collection %q (Azure Firewall %q / Resource Group %q)", name, firewallName, resourceGroup)
+	}
+	d.SetId(collectionID)
+
+	return resourceArmFirewallApplicationRuleCollectionRead(d, meta)
+}
+
+func resourceArmFirewallApplicationRuleCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).azureFirewallsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	firewallName := id.Path["azureFirewalls"]
+	name := id.Path["applicationRuleCollections"]
+
+	read, err := client.Get(ctx, resourceGroup, firewallName)
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] Azure Firewall %q (Resource Group %q) was not found - removing from state!", firewallName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Azure Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	if read.AzureFirewallPropertiesFormat == nil {
+		return fmt.Errorf("Error retrieving Application Rule Collection %q (Firewall %q / Resource Group %q): `props` was nil", name, firewallName, resourceGroup)
+	}
+	props := *read.AzureFirewallPropertiesFormat
+
+	var rule *network.AzureFirewallApplicationRuleCollection
+	if props.ApplicationRuleCollections != nil {
+		for _, r := range *props.ApplicationRuleCollections {
+			if r.Name == nil {
+				continue
+			}
+
+			if *r.Name == name {
+				rule = &r
+				break
+			}
+		}
+	}
+
+	if rule == nil {
+		log.Printf("[DEBUG] Application Rule Collection %q was not found on Firewall %q (Resource Group %q) - removing from state!", name, firewallName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("azure_firewall_name", firewallName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := rule.AzureFirewallApplicationRuleCollectionPropertiesFormat; props != nil {
+		if action := props.Action; action != nil {
+			d.Set("action", string(action.Type))
+		}
+
+		if priority := props.Priority; priority != nil {
+			d.Set("priority", int(*priority))
+		}
+
+		flattenedRules := flattenFirewallApplicationRuleCollectionRules(props.Rules)
+		if err := d.Set("rule", flattenedRules); err != nil {
+			return fmt.Errorf("Error setting `rule`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmFirewallApplicationRuleCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).azureFirewallsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	firewallName := id.Path["azureFirewalls"]
+	name := id.Path["applicationRuleCollections"]
+
+	azureRMLockByName(firewallName, azureFirewallResourceName)
+	defer azureRMUnlockByName(firewallName, azureFirewallResourceName)
+
+	firewall, err := client.Get(ctx, resourceGroup, firewallName)
+	if err != nil {
+		if utils.ResponseWasNotFound(firewall.Response) {
+			// assume deleted
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Azure Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	props := firewall.AzureFirewallPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error retrieving Application Rule Collection %q (Firewall %q / Resource Group %q): `props` was nil", name, firewallName, resourceGroup)
+	}
+	if props.ApplicationRuleCollections == nil {
+		// assume deleted
+		return nil
+	}
+
+	applicationRules := make([]network.AzureFirewallApplicationRuleCollection, 0)
+	for _, rule := range *props.ApplicationRuleCollections {
+		if rule.Name == nil {
+			continue
+		}
+
+		if *rule.Name != name {
+			applicationRules = append(applicationRules, rule)
+		}
+	}
+	props.ApplicationRuleCollections = &applicationRules
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, firewallName, firewall)
+	if err != nil {
+		return fmt.Errorf("Error deleting Application Rule Collection %q from Firewall %q (Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Application Rule Collection %q from Firewall %q (Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmFirewallApplicationRules(input *schema.Set) ([]network.AzureFirewallApplicationRule, error) {
+	appRules := input.List()
+	rules := make([]network.AzureFirewallApplicationRule, 0)
+
+	for _, appRule := range appRules {
+		rule := appRule.(map[string]interface{})
+
+		name := rule["name"].(string)
+		description := rule["description"].(string)
+
+		sourceAddresses := make([]string, 0)
+		for _, v := range rule["source_addresses"].(*schema.Set).List() {
+			sourceAddresses = append(sourceAddresses, v.(string))
+		}
+
+		fqdnTags := make([]string, 0)
+		for _, v := range rule["fqdn_tags"].(*schema.Set).List() {
+			fqdnTags = append(fqdnTags, v.(string))
+		}
+
+		targetFqdns := make([]string, 0)
+		for _, v := range rule["target_fqdns"].(*schema.Set).List() {
+			targetFqdns = append(targetFqdns, v.(string))
+		}
+
+		// the API accepts either a list of FQDN Tags, or a list of Target FQDNs along with the Protocols to use
+		protocols := rule["protocol"].([]interface{})
+		if len(fqdnTags) > 0 && (len(targetFqdns) > 0 || len(protocols) > 0) {
+			return nil, fmt.Errorf("`fqdn_tags` cannot be specified with `target_fqdns` or `protocol` in rule %q", name)
+		}
+		if len(fqdnTags) == 0 && len(targetFqdns) == 0 {
+			return nil, fmt.Errorf("One of `fqdn_tags` or `target_fqdns` must be specified in rule %q", name)
+		}
+		if len(targetFqdns) > 0 && len(protocols) == 0 {
+			return nil, fmt.Errorf("At least one `protocol` must be specified with `target_fqdns` in rule %q", name)
+		}
+
+		ruleToAdd := network.AzureFirewallApplicationRule{
+			Name:            utils.String(name),
+			Description:     utils.String(description),
+			SourceAddresses: &sourceAddresses,
+			FqdnTags:        &fqdnTags,
+			TargetFqdns:     &targetFqdns,
+		}
+
+		ruleProtocols := make([]network.AzureFirewallApplicationRuleProtocol, 0)
+		for _, v := range protocols {
+			protocol := v.(map[string]interface{})
+			ruleProtocol := network.AzureFirewallApplicationRuleProtocol{
+				ProtocolType: network.AzureFirewallApplicationRuleProtocolType(protocol["type"].(string)),
+			}
+			if port := protocol["port"].(int); port > 0 {
+				ruleProtocol.Port = utils.Int32(int32(port))
+			}
+			ruleProtocols = append(ruleProtocols, ruleProtocol)
+		}
+		ruleToAdd.Protocols = &ruleProtocols
+		rules = append(rules, ruleToAdd)
+	}
+
+	return rules, nil
+}
+
+func flattenFirewallApplicationRuleCollectionRules(rules *[]network.AzureFirewallApplicationRule) []map[string]interface{} {
+	outputs := make([]map[string]interface{}, 0)
+	if rules == nil {
+		return outputs
+	}
+
+	for _, rule := range *rules {
+		output := make(map[string]interface{})
+		if rule.Name != nil {
+			output["name"] = *rule.Name
+		}
+		if rule.Description != nil {
+			output["description"] = *rule.Description
+		}
+		if rule.SourceAddresses != nil {
+			output["source_addresses"] = sliceToSet(*rule.SourceAddresses)
+		}
+		if rule.FqdnTags != nil {
+			output["fqdn_tags"] = sliceToSet(*rule.FqdnTags)
+		}
+		if rule.TargetFqdns != nil {
+			output["target_fqdns"] = sliceToSet(*rule.TargetFqdns)
+		}
+		protocols := make([]map[string]interface{}, 0)
+		if rule.Protocols != nil {
+			for _, protocol := range *rule.Protocols {
+				p := make(map[string]interface{})
+				p["type"] = string(protocol.ProtocolType)
+				if port := protocol.Port; port != nil {
+					p["port"] = int(*port)
+				}
+				protocols = append(protocols, p)
+			}
+		}
+		output["protocol"] = protocols
+		outputs = append(outputs, output)
+	}
+	return outputs
+}
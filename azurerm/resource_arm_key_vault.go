@@ -39,6 +39,8 @@ func resourceArmKeyVault() *schema.Resource {
 		MigrateState:  resourceAzureRMKeyVaultMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: resourceArmKeyVaultCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -123,6 +125,17 @@ func resourceArmKeyVault() *schema.Resource {
 				Optional: true,
 			},
 
+			"soft_delete_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"purge_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"network_acls": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -166,6 +179,28 @@ func resourceArmKeyVault() *schema.Resource {
 	}
 }
 
+func resourceArmKeyVaultCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	softDeleteEnabled := d.Get("soft_delete_enabled").(bool)
+	purgeProtectionEnabled := d.Get("purge_protection_enabled").(bool)
+
+	if purgeProtectionEnabled && !softDeleteEnabled {
+		return fmt.Errorf("`soft_delete_enabled` must be set to `true` when `purge_protection_enabled` is enabled")
+	}
+
+	// once enabled, neither Soft Delete or Purge Protection can be disabled
+	if d.Id() != "" {
+		if old, _ := d.GetChange("soft_delete_enabled"); old.(bool) && !softDeleteEnabled {
+			return fmt.Errorf("once enabled `soft_delete_enabled` cannot be disabled")
+		}
+
+		if old, _ := d.GetChange("purge_protection_enabled"); old.(bool) && !purgeProtectionEnabled {
+			return fmt.Errorf("once enabled `purge_protection_enabled` cannot be disabled")
+		}
+	}
+
+	return nil
+}
+
 func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyVaultClient
 	ctx := meta.(*ArmClient).StopContext
@@ -178,6 +213,8 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	enabledForDeployment := d.Get("enabled_for_deployment").(bool)
 	enabledForDiskEncryption := d.Get("enabled_for_disk_encryption").(bool)
 	enabledForTemplateDeployment := d.Get("enabled_for_template_deployment").(bool)
+	softDeleteEnabled := d.Get("soft_delete_enabled").(bool)
+	purgeProtectionEnabled := d.Get("purge_protection_enabled").(bool)
 	tags := d.Get("tags").(map[string]interface{})
 
 	networkAclsRaw := d.Get("network_acls").([]interface{})
@@ -203,6 +240,31 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		Tags: expandTags(tags),
 	}
 
+	// the API only accepts these fields being set to `true`
+	if softDeleteEnabled {
+		parameters.Properties.EnableSoftDelete = utils.Bool(true)
+	}
+	if purgeProtectionEnabled {
+		parameters.Properties.EnablePurgeProtection = utils.Bool(true)
+	}
+
+	// a soft-deleted Key Vault with the same name blocks the creation of a new Key Vault
+	if d.IsNewResource() {
+		deleted, err := client.GetDeleted(ctx, name, location)
+		if err != nil {
+			if !utils.ResponseWasNotFound(deleted.Response) {
+				return fmt.Errorf("Error checking for the presence of a soft-deleted Key Vault %q (Location %q): %+v", name, location, err)
+			}
+		} else if deleted.ID != nil {
+			if !meta.(*ArmClient).features.keyVault.recoverSoftDeletedVaults {
+				return fmt.Errorf("A soft-deleted Key Vault %q exists in %q which must be recovered or purged before it can be created - alternatively set `recover_soft_deleted_key_vaults` to `true` in the `features` block of the Provider to recover it automatically", name, location)
+			}
+
+			log.Printf("[DEBUG] Recovering soft-deleted Key Vault %q (Location %q)..", name, location)
+			parameters.Properties.CreateMode = keyvault.CreateModeRecover
+		}
+	}
+
 	// Locking this resource so we don't make modifications to it at the same time if there is a
 	// key vault access policy trying to update it as well
 	azureRMLockByName(name, keyVaultResourceName)
@@ -295,6 +357,8 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("enabled_for_deployment", props.EnabledForDeployment)
 		d.Set("enabled_for_disk_encryption", props.EnabledForDiskEncryption)
 		d.Set("enabled_for_template_deployment", props.EnabledForTemplateDeployment)
+		d.Set("soft_delete_enabled", props.EnableSoftDelete)
+		d.Set("purge_protection_enabled", props.EnablePurgeProtection)
 		d.Set("vault_uri", props.VaultURI)
 
 		if err := d.Set("sku", flattenKeyVaultSku(props.Sku)); err != nil {
@@ -372,6 +436,36 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// a soft-deleted Key Vault can only be purged when Purge Protection isn't enabled
+	if !meta.(*ArmClient).features.keyVault.purgeSoftDeletedVaultsOnDestroy {
+		return nil
+	}
+
+	props := read.Properties
+	if props == nil || props.EnableSoftDelete == nil || !*props.EnableSoftDelete {
+		return nil
+	}
+
+	if props.EnablePurgeProtection != nil && *props.EnablePurgeProtection {
+		log.Printf("[DEBUG] Key Vault %q (Resource Group %q) can't be purged since Purge Protection is enabled", name, resourceGroup)
+		return nil
+	}
+
+	if read.Location == nil {
+		return fmt.Errorf("Error purging soft-deleted Key Vault %q (Resource Group %q): `location` was nil", name, resourceGroup)
+	}
+	location := azureRMNormalizeLocation(*read.Location)
+
+	log.Printf("[DEBUG] Purging soft-deleted Key Vault %q (Location %q)..", name, location)
+	future, err := client.PurgeDeleted(ctx, name, location)
+	if err != nil {
+		return fmt.Errorf("Error purging soft-deleted Key Vault %q (Location %q): %+v", name, location, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the purge of soft-deleted Key Vault %q (Location %q): %+v", name, location, err)
+	}
+
 	return nil
 }
 
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -304,6 +305,11 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 	keyVaultBaseUrl := d.Get("vault_uri").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
+	softDelete := keyVaultCertificateSoftDelete(client, keyVaultBaseUrl, name)
+	if err := softDelete.recoverIfSoftDeleted(ctx, meta.(*ArmClient).features.keyVault.recoverSoftDeletedCertificates); err != nil {
+		return err
+	}
+
 	policy := expandKeyVaultCertificatePolicy(d)
 
 	if v, ok := d.GetOk("certificate"); ok {
@@ -434,7 +440,42 @@ func resourceArmKeyVaultCertificateDelete(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error deleting Certificate %q from Key Vault: %+v", id.Name, err)
 	}
 
-	return nil
+	// the Certificate is only soft-deleted when Soft Delete is enabled on the Key Vault
+	if !meta.(*ArmClient).features.keyVault.purgeSoftDeletedCertificatesOnDestroy || resp.RecoveryID == nil {
+		return nil
+	}
+
+	if attributes := resp.Attributes; attributes == nil || !keyVaultRecoveryLevelIsPurgeable(string(attributes.RecoveryLevel)) {
+		log.Printf("[DEBUG] Certificate %q (Key Vault %q) can't be purged since Purge Protection is enabled", id.Name, id.KeyVaultBaseUrl)
+		return nil
+	}
+
+	return keyVaultCertificateSoftDelete(client, id.KeyVaultBaseUrl, id.Name).purgeSoftDeleted(ctx)
+}
+
+func keyVaultCertificateSoftDelete(client keyvault.BaseClient, keyVaultBaseUrl string, name string) keyVaultChildItemSoftDelete {
+	return keyVaultChildItemSoftDelete{
+		itemType:        "Certificate",
+		featureFlag:     "recover_soft_deleted_certificates",
+		name:            name,
+		keyVaultBaseUrl: keyVaultBaseUrl,
+		get: func(ctx context.Context) (autorest.Response, error) {
+			// "" indicates the latest version
+			resp, err := client.GetCertificate(ctx, keyVaultBaseUrl, name, "")
+			return resp.Response, err
+		},
+		getDeleted: func(ctx context.Context) (autorest.Response, error) {
+			resp, err := client.GetDeletedCertificate(ctx, keyVaultBaseUrl, name)
+			return resp.Response, err
+		},
+		recover: func(ctx context.Context) error {
+			_, err := client.RecoverDeletedCertificate(ctx, keyVaultBaseUrl, name)
+			return err
+		},
+		purge: func(ctx context.Context) (autorest.Response, error) {
+			return client.PurgeDeletedCertificate(ctx, keyVaultBaseUrl, name)
+		},
+	}
 }
 
 func expandKeyVaultCertificatePolicy(d *schema.ResourceData) keyvault.CertificatePolicy {
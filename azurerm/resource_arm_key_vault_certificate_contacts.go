@@ -0,0 +1,215 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKeyVaultCertificateContacts() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultCertificateContactsCreateUpdate,
+		Read:   resourceArmKeyVaultCertificateContactsRead,
+		Update: resourceArmKeyVaultCertificateContactsCreateUpdate,
+		Delete: resourceArmKeyVaultCertificateContactsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vault_uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.URLIsHTTPS,
+			},
+
+			"contact": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"phone": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmKeyVaultCertificateContactsCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Print("[INFO] preparing arguments for AzureRM KeyVault Certificate Contacts creation.")
+
+	keyVaultBaseUrl := d.Get("vault_uri").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.GetCertificateContacts(ctx, keyVaultBaseUrl)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Certificate Contacts (Key Vault %q): %s", keyVaultBaseUrl, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_key_vault_certificate_contacts", *existing.ID)
+		}
+	}
+
+	parameters := keyvault.Contacts{
+		ContactList: expandKeyVaultCertificateContacts(d.Get("contact").([]interface{})),
+	}
+
+	if _, err := client.SetCertificateContacts(ctx, keyVaultBaseUrl, parameters); err != nil {
+		return fmt.Errorf("Error setting Certificate Contacts (Key Vault %q): %+v", keyVaultBaseUrl, err)
+	}
+
+	read, err := client.GetCertificateContacts(ctx, keyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Certificate Contacts (Key Vault %q): %+v", keyVaultBaseUrl, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read KeyVault Certificate Contacts (in key vault '%s')", keyVaultBaseUrl)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmKeyVaultCertificateContactsRead(d, meta)
+}
+
+func resourceArmKeyVaultCertificateContactsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	keyVaultBaseUrl, err := parseKeyVaultCertificateContactsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetCertificateContacts(ctx, keyVaultBaseUrl)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Certificate Contacts were not found in Key Vault at URI %q - removing from state", keyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Certificate Contacts (Key Vault %q): %+v", keyVaultBaseUrl, err)
+	}
+
+	d.Set("vault_uri", keyVaultBaseUrl)
+	if err := d.Set("contact", flattenKeyVaultCertificateContacts(resp.ContactList)); err != nil {
+		return fmt.Errorf("Error setting `contact`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmKeyVaultCertificateContactsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	keyVaultBaseUrl, err := parseKeyVaultCertificateContactsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteCertificateContacts(ctx, keyVaultBaseUrl)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Certificate Contacts (Key Vault %q): %+v", keyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+// parseKeyVaultCertificateContactsID returns the Base URL of the Key Vault which the Certificate Contacts belong to
+func parseKeyVaultCertificateContactsID(id string) (string, error) {
+	// example: https://example-keyvault.vault.azure.net/certificates/contacts
+	idURL, err := url.ParseRequestURI(id)
+	if err != nil {
+		return "", fmt.Errorf("Cannot parse Azure KeyVault Certificate Contacts Id: %s", err)
+	}
+
+	if path := strings.Trim(idURL.Path, "/"); path != "certificates/contacts" {
+		return "", fmt.Errorf("Azure KeyVault Certificate Contacts Id should be in the format `{vaultUri}/certificates/contacts`, got %q", path)
+	}
+
+	return fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host), nil
+}
+
+func expandKeyVaultCertificateContacts(input []interface{}) *[]keyvault.Contact {
+	results := make([]keyvault.Contact, 0)
+
+	for _, v := range input {
+		contact := v.(map[string]interface{})
+
+		result := keyvault.Contact{
+			EmailAddress: utils.String(contact["email"].(string)),
+		}
+		if v := contact["name"].(string); v != "" {
+			result.Name = utils.String(v)
+		}
+		if v := contact["phone"].(string); v != "" {
+			result.Phone = utils.String(v)
+		}
+
+		results = append(results, result)
+	}
+
+	return &results
+}
+
+func flattenKeyVaultCertificateContacts(input *[]keyvault.Contact) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, contact := range *input {
+		result := make(map[string]interface{})
+
+		if contact.EmailAddress != nil {
+			result["email"] = *contact.EmailAddress
+		}
+		if contact.Name != nil {
+			result["name"] = *contact.Name
+		}
+		if contact.Phone != nil {
+			result["phone"] = *contact.Phone
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
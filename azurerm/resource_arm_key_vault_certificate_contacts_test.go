@@ -0,0 +1,174 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMKeyVaultCertificateContacts_basic(t *testing.T) {
+	resourceName := "azurerm_key_vault_certificate_contacts.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultCertificateContactsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultCertificateContacts_basic(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateContactsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "contact.0.email", "admin@contoso.com"),
+				),
+			},
+			{
+				Config: testAccAzureRMKeyVaultCertificateContacts_complete(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateContactsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "contact.1.email", "security@contoso.com"),
+					resource.TestCheckResourceAttr(resourceName, "contact.1.name", "Security Team"),
+					resource.TestCheckResourceAttr(resourceName, "contact.1.phone", "01234567890"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultCertificateContactsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_key_vault_certificate_contacts" {
+			continue
+		}
+
+		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
+
+		resp, err := client.GetCertificateContacts(ctx, vaultBaseUrl)
+		if err != nil {
+			// the Key Vault may have been deleted
+			if utils.ResponseWasNotFound(resp.Response) || resp.Response.Response == nil {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Key Vault Certificate Contacts still exist:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMKeyVaultCertificateContactsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetCertificateContacts(ctx, vaultBaseUrl)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Key Vault Certificate Contacts (Key Vault %q) do not exist", vaultBaseUrl)
+			}
+
+			return fmt.Errorf("Bad: Get on keyVaultManagementClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMKeyVaultCertificateContacts_template(rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkeyvault%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    certificate_permissions = [
+      "managecontacts",
+    ]
+
+    key_permissions = [
+      "create",
+    ]
+
+    secret_permissions = [
+      "set",
+    ]
+  }
+}
+`, rString, location, rString)
+}
+
+func testAccAzureRMKeyVaultCertificateContacts_basic(rString string, location string) string {
+	template := testAccAzureRMKeyVaultCertificateContacts_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate_contacts" "test" {
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+
+  contact {
+    email = "admin@contoso.com"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMKeyVaultCertificateContacts_complete(rString string, location string) string {
+	template := testAccAzureRMKeyVaultCertificateContacts_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate_contacts" "test" {
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+
+  contact {
+    email = "admin@contoso.com"
+  }
+
+  contact {
+    email = "security@contoso.com"
+    name  = "Security Team"
+    phone = "01234567890"
+  }
+}
+`, template)
+}
@@ -0,0 +1,314 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKeyVaultCertificateIssuer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultCertificateIssuerCreateUpdate,
+		Read:   resourceArmKeyVaultCertificateIssuerRead,
+		Update: resourceArmKeyVaultCertificateIssuerCreateUpdate,
+		Delete: resourceArmKeyVaultCertificateIssuerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateKeyVaultChildName,
+			},
+
+			"vault_uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.URLIsHTTPS,
+			},
+
+			"provider_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"DigiCert",
+					"GlobalSign",
+					"OneCertV2-PrivateCA",
+					"OneCertV2-PublicCA",
+					"SslAdminV2",
+				}, false),
+			},
+
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// the password isn't returned by the API, so it's only available from the config
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"org_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"admin": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"first_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"last_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"phone": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmKeyVaultCertificateIssuerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Print("[INFO] preparing arguments for AzureRM KeyVault Certificate Issuer creation.")
+
+	name := d.Get("name").(string)
+	keyVaultBaseUrl := d.Get("vault_uri").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.GetCertificateIssuer(ctx, keyVaultBaseUrl, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Certificate Issuer %q (Key Vault %q): %s", name, keyVaultBaseUrl, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_key_vault_certificate_issuer", *existing.ID)
+		}
+	}
+
+	parameters := keyvault.CertificateIssuerSetParameters{
+		Provider:            utils.String(d.Get("provider_name").(string)),
+		OrganizationDetails: expandKeyVaultCertificateIssuerOrganizationDetails(d),
+	}
+
+	accountId := d.Get("account_id").(string)
+	password := d.Get("password").(string)
+	if accountId != "" || password != "" {
+		credentials := keyvault.IssuerCredentials{}
+		if accountId != "" {
+			credentials.AccountID = utils.String(accountId)
+		}
+		if password != "" {
+			credentials.Password = utils.String(password)
+		}
+		parameters.Credentials = &credentials
+	}
+
+	if _, err := client.SetCertificateIssuer(ctx, keyVaultBaseUrl, name, parameters); err != nil {
+		return fmt.Errorf("Error setting Certificate Issuer %q (Key Vault %q): %+v", name, keyVaultBaseUrl, err)
+	}
+
+	read, err := client.GetCertificateIssuer(ctx, keyVaultBaseUrl, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Certificate Issuer %q (Key Vault %q): %+v", name, keyVaultBaseUrl, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read KeyVault Certificate Issuer '%s' (in key vault '%s')", name, keyVaultBaseUrl)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmKeyVaultCertificateIssuerRead(d, meta)
+}
+
+func resourceArmKeyVaultCertificateIssuerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseKeyVaultCertificateIssuerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetCertificateIssuer(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Certificate Issuer %q was not found in Key Vault at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Certificate Issuer %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("vault_uri", id.KeyVaultBaseUrl)
+	d.Set("provider_name", resp.Provider)
+
+	if credentials := resp.Credentials; credentials != nil {
+		d.Set("account_id", credentials.AccountID)
+	}
+
+	orgId := ""
+	admins := make([]interface{}, 0)
+	if details := resp.OrganizationDetails; details != nil {
+		if details.ID != nil {
+			orgId = *details.ID
+		}
+		admins = flattenKeyVaultCertificateIssuerAdmins(details.AdminDetails)
+	}
+	d.Set("org_id", orgId)
+	if err := d.Set("admin", admins); err != nil {
+		return fmt.Errorf("Error setting `admin`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmKeyVaultCertificateIssuerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseKeyVaultCertificateIssuerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteCertificateIssuer(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Certificate Issuer %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+type keyVaultCertificateIssuerID struct {
+	KeyVaultBaseUrl string
+	Name            string
+}
+
+func parseKeyVaultCertificateIssuerID(id string) (*keyVaultCertificateIssuerID, error) {
+	// example: https://example-keyvault.vault.azure.net/certificates/issuers/example
+	idURL, err := url.ParseRequestURI(id)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse Azure KeyVault Certificate Issuer Id: %s", err)
+	}
+
+	path := strings.Trim(idURL.Path, "/")
+	components := strings.Split(path, "/")
+
+	if len(components) != 3 || components[0] != "certificates" || components[1] != "issuers" {
+		return nil, fmt.Errorf("Azure KeyVault Certificate Issuer Id should be in the format `{vaultUri}/certificates/issuers/{name}`, got %q", path)
+	}
+
+	return &keyVaultCertificateIssuerID{
+		KeyVaultBaseUrl: fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
+		Name:            components[2],
+	}, nil
+}
+
+func expandKeyVaultCertificateIssuerOrganizationDetails(d *schema.ResourceData) *keyvault.OrganizationDetails {
+	details := keyvault.OrganizationDetails{}
+
+	if orgId := d.Get("org_id").(string); orgId != "" {
+		details.ID = utils.String(orgId)
+	}
+
+	admins := make([]keyvault.AdministratorDetails, 0)
+	for _, v := range d.Get("admin").([]interface{}) {
+		admin := v.(map[string]interface{})
+
+		result := keyvault.AdministratorDetails{
+			EmailAddress: utils.String(admin["email_address"].(string)),
+		}
+		if v := admin["first_name"].(string); v != "" {
+			result.FirstName = utils.String(v)
+		}
+		if v := admin["last_name"].(string); v != "" {
+			result.LastName = utils.String(v)
+		}
+		if v := admin["phone"].(string); v != "" {
+			result.Phone = utils.String(v)
+		}
+
+		admins = append(admins, result)
+	}
+	details.AdminDetails = &admins
+
+	return &details
+}
+
+func flattenKeyVaultCertificateIssuerAdmins(input *[]keyvault.AdministratorDetails) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, admin := range *input {
+		result := make(map[string]interface{})
+
+		if admin.EmailAddress != nil {
+			result["email_address"] = *admin.EmailAddress
+		}
+		if admin.FirstName != nil {
+			result["first_name"] = *admin.FirstName
+		}
+		if admin.LastName != nil {
+			result["last_name"] = *admin.LastName
+		}
+		if admin.Phone != nil {
+			result["phone"] = *admin.Phone
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,263 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParseKeyVaultCertificateIssuerID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ExpectError bool
+		Expected    *keyVaultCertificateIssuerID
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://example.vault.azure.net/certificates/issuers",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://example.vault.azure.net/secrets/example/fdf067c93bbb4b22bff4d8b7a9a56217",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://example.vault.azure.net/certificates/issuers/example",
+			ExpectError: false,
+			Expected: &keyVaultCertificateIssuerID{
+				KeyVaultBaseUrl: "https://example.vault.azure.net/",
+				Name:            "example",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := parseKeyVaultCertificateIssuerID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID %q: %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for ID %q but didn't get one", tc.Input)
+		}
+
+		if actual.KeyVaultBaseUrl != tc.Expected.KeyVaultBaseUrl {
+			t.Fatalf("Expected Key Vault Base Url to be %q but got %q for ID %q", tc.Expected.KeyVaultBaseUrl, actual.KeyVaultBaseUrl, tc.Input)
+		}
+
+		if actual.Name != tc.Expected.Name {
+			t.Fatalf("Expected Name to be %q but got %q for ID %q", tc.Expected.Name, actual.Name, tc.Input)
+		}
+	}
+}
+
+func TestAccAzureRMKeyVaultCertificateIssuer_basic(t *testing.T) {
+	resourceName := "azurerm_key_vault_certificate_issuer.test"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultCertificateIssuer_basic(rs, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultCertificateIssuerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateIssuerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "DigiCert"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultCertificateIssuer_complete(t *testing.T) {
+	resourceName := "azurerm_key_vault_certificate_issuer.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultCertificateIssuerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultCertificateIssuer_basic(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateIssuerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admin.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMKeyVaultCertificateIssuer_complete(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateIssuerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "org_id", "accTestOrg"),
+					resource.TestCheckResourceAttr(resourceName, "admin.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "admin.0.email_address", "admin@contoso.com"),
+					resource.TestCheckResourceAttr(resourceName, "admin.0.first_name", "First"),
+					resource.TestCheckResourceAttr(resourceName, "admin.0.last_name", "Last"),
+					resource.TestCheckResourceAttr(resourceName, "admin.0.phone", "01234567890"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultCertificateIssuerDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_key_vault_certificate_issuer" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
+
+		resp, err := client.GetCertificateIssuer(ctx, vaultBaseUrl, name)
+		if err != nil {
+			// the Key Vault may have been deleted
+			if utils.ResponseWasNotFound(resp.Response) || resp.Response.Response == nil {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Key Vault Certificate Issuer still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMKeyVaultCertificateIssuerExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+		name := rs.Primary.Attributes["name"]
+		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetCertificateIssuer(ctx, vaultBaseUrl, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Key Vault Certificate Issuer %q (Key Vault %q) does not exist", name, vaultBaseUrl)
+			}
+
+			return fmt.Errorf("Bad: Get on keyVaultManagementClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMKeyVaultCertificateIssuer_template(rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkeyvault%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    certificate_permissions = [
+      "deleteissuers",
+      "getissuers",
+      "listissuers",
+      "manageissuers",
+      "setissuers",
+    ]
+
+    key_permissions = [
+      "create",
+    ]
+
+    secret_permissions = [
+      "set",
+    ]
+  }
+}
+`, rString, location, rString)
+}
+
+func testAccAzureRMKeyVaultCertificateIssuer_basic(rString string, location string) string {
+	template := testAccAzureRMKeyVaultCertificateIssuer_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate_issuer" "test" {
+  name          = "acctestKVCI-%s"
+  vault_uri     = "${azurerm_key_vault.test.vault_uri}"
+  provider_name = "DigiCert"
+  account_id    = "test-account"
+  password      = "test"
+}
+`, template, rString)
+}
+
+func testAccAzureRMKeyVaultCertificateIssuer_complete(rString string, location string) string {
+	template := testAccAzureRMKeyVaultCertificateIssuer_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate_issuer" "test" {
+  name          = "acctestKVCI-%s"
+  vault_uri     = "${azurerm_key_vault.test.vault_uri}"
+  provider_name = "DigiCert"
+  account_id    = "test-account"
+  password      = "test"
+  org_id        = "accTestOrg"
+
+  admin {
+    email_address = "admin@contoso.com"
+    first_name    = "First"
+    last_name     = "Last"
+    phone         = "01234567890"
+  }
+}
+`, template, rString)
+}
@@ -1,13 +1,16 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -22,6 +25,8 @@ func resourceArmKeyVaultKey() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: keyVaultChildItemDatesCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -76,12 +81,34 @@ func resourceArmKeyVaultKey() *schema.Resource {
 				},
 			},
 
+			"not_before_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateRFC3339Date,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
+			"expiration_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateRFC3339Date,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
 			// Computed
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"n": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -109,13 +136,20 @@ func resourceArmKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) erro
 	keyOptions := expandKeyVaultKeyOptions(d)
 	tags := d.Get("tags").(map[string]interface{})
 
+	softDelete := keyVaultKeySoftDelete(client, keyVaultBaseUrl, name)
+	if err := softDelete.recoverIfSoftDeleted(ctx, meta.(*ArmClient).features.keyVault.recoverSoftDeletedKeys); err != nil {
+		return err
+	}
+
 	// TODO: support Importing Keys once this is fixed:
 	// https://github.com/Azure/azure-rest-api-specs/issues/1747
 	parameters := keyvault.KeyCreateParameters{
 		Kty:    keyvault.JSONWebKeyType(keyType),
 		KeyOps: keyOptions,
 		KeyAttributes: &keyvault.KeyAttributes{
-			Enabled: utils.Bool(true),
+			Enabled:   utils.Bool(true),
+			NotBefore: expandKeyVaultChildItemDate(d.Get("not_before_date").(string)),
+			Expires:   expandKeyVaultChildItemDate(d.Get("expiration_date").(string)),
 		},
 		KeySize: utils.Int32(int32(d.Get("key_size").(int))),
 		Tags:    expandTags(tags),
@@ -152,7 +186,9 @@ func resourceArmKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) erro
 	parameters := keyvault.KeyUpdateParameters{
 		KeyOps: keyOptions,
 		KeyAttributes: &keyvault.KeyAttributes{
-			Enabled: utils.Bool(true),
+			Enabled:   utils.Bool(true),
+			NotBefore: expandKeyVaultChildItemDate(d.Get("not_before_date").(string)),
+			Expires:   expandKeyVaultChildItemDate(d.Get("expiration_date").(string)),
 		},
 		Tags: expandTags(tags),
 	}
@@ -199,9 +235,22 @@ func resourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("e", key.E)
 	}
 
+	if attributes := resp.Attributes; attributes != nil {
+		d.Set("not_before_date", flattenKeyVaultChildItemDate(attributes.NotBefore))
+		d.Set("expiration_date", flattenKeyVaultChildItemDate(attributes.Expires))
+	}
+
 	// Computed
 	d.Set("version", id.Version)
 
+	versions, err := keyVaultKeyVersions(ctx, client, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("Error setting `versions`: %+v", err)
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -216,8 +265,47 @@ func resourceArmKeyVaultKeyDelete(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	_, err = client.DeleteKey(ctx, id.KeyVaultBaseUrl, id.Name)
-	return err
+	resp, err := client.DeleteKey(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		return err
+	}
+
+	// the Key is only soft-deleted when Soft Delete is enabled on the Key Vault
+	if !meta.(*ArmClient).features.keyVault.purgeSoftDeletedKeysOnDestroy || resp.RecoveryID == nil {
+		return nil
+	}
+
+	if attributes := resp.Attributes; attributes == nil || !keyVaultRecoveryLevelIsPurgeable(string(attributes.RecoveryLevel)) {
+		log.Printf("[DEBUG] Key %q (Key Vault %q) can't be purged since Purge Protection is enabled", id.Name, id.KeyVaultBaseUrl)
+		return nil
+	}
+
+	return keyVaultKeySoftDelete(client, id.KeyVaultBaseUrl, id.Name).purgeSoftDeleted(ctx)
+}
+
+func keyVaultKeySoftDelete(client keyvault.BaseClient, keyVaultBaseUrl string, name string) keyVaultChildItemSoftDelete {
+	return keyVaultChildItemSoftDelete{
+		itemType:        "Key",
+		featureFlag:     "recover_soft_deleted_keys",
+		name:            name,
+		keyVaultBaseUrl: keyVaultBaseUrl,
+		get: func(ctx context.Context) (autorest.Response, error) {
+			// "" indicates the latest version
+			resp, err := client.GetKey(ctx, keyVaultBaseUrl, name, "")
+			return resp.Response, err
+		},
+		getDeleted: func(ctx context.Context) (autorest.Response, error) {
+			resp, err := client.GetDeletedKey(ctx, keyVaultBaseUrl, name)
+			return resp.Response, err
+		},
+		recover: func(ctx context.Context) error {
+			_, err := client.RecoverDeletedKey(ctx, keyVaultBaseUrl, name)
+			return err
+		},
+		purge: func(ctx context.Context) (autorest.Response, error) {
+			return client.PurgeDeletedKey(ctx, keyVaultBaseUrl, name)
+		},
+	}
 }
 
 func expandKeyVaultKeyOptions(d *schema.ResourceData) *[]keyvault.JSONWebKeyOperation {
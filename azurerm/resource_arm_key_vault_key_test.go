@@ -104,6 +104,9 @@ func TestAccAzureRMKeyVaultKey_complete(t *testing.T) {
 					testCheckAzureRMKeyVaultKeyExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.hello", "world"),
+					resource.TestCheckResourceAttr(resourceName, "not_before_date", "2019-01-01T01:02:03Z"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_date", "2030-01-01T01:02:03Z"),
+					resource.TestCheckResourceAttr(resourceName, "versions.#", "1"),
 				),
 			},
 			{
@@ -500,6 +503,9 @@ resource "azurerm_key_vault_key" "test" {
     "wrapKey",
   ]
 
+  not_before_date = "2019-01-01T01:02:03Z"
+  expiration_date = "2030-01-01T01:02:03Z"
+
   tags {
     "hello" = "world"
   }
@@ -1,12 +1,15 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -21,6 +24,8 @@ func resourceArmKeyVaultSecret() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: keyVaultChildItemDatesCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -47,11 +52,33 @@ func resourceArmKeyVaultSecret() *schema.Resource {
 				Optional: true,
 			},
 
+			"not_before_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateRFC3339Date,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
+			"expiration_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateRFC3339Date,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -69,10 +96,19 @@ func resourceArmKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) e
 	contentType := d.Get("content_type").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
+	softDelete := keyVaultSecretSoftDelete(client, keyVaultBaseUrl, name)
+	if err := softDelete.recoverIfSoftDeleted(ctx, meta.(*ArmClient).features.keyVault.recoverSoftDeletedSecrets); err != nil {
+		return err
+	}
+
 	parameters := keyvault.SecretSetParameters{
 		Value:       utils.String(value),
 		ContentType: utils.String(contentType),
 		Tags:        expandTags(tags),
+		SecretAttributes: &keyvault.SecretAttributes{
+			NotBefore: expandKeyVaultChildItemDate(d.Get("not_before_date").(string)),
+			Expires:   expandKeyVaultChildItemDate(d.Get("expiration_date").(string)),
+		},
 	}
 
 	if _, err := client.SetSecret(ctx, keyVaultBaseUrl, name, parameters); err != nil {
@@ -106,13 +142,18 @@ func resourceArmKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) e
 	value := d.Get("value").(string)
 	contentType := d.Get("content_type").(string)
 	tags := d.Get("tags").(map[string]interface{})
+	attributes := &keyvault.SecretAttributes{
+		NotBefore: expandKeyVaultChildItemDate(d.Get("not_before_date").(string)),
+		Expires:   expandKeyVaultChildItemDate(d.Get("expiration_date").(string)),
+	}
 
 	if d.HasChange("value") {
 		// for changing the value of the secret we need to create a new version
 		parameters := keyvault.SecretSetParameters{
-			Value:       utils.String(value),
-			ContentType: utils.String(contentType),
-			Tags:        expandTags(tags),
+			Value:            utils.String(value),
+			ContentType:      utils.String(contentType),
+			Tags:             expandTags(tags),
+			SecretAttributes: attributes,
 		}
 
 		if _, err = client.SetSecret(ctx, id.KeyVaultBaseUrl, id.Name, parameters); err != nil {
@@ -133,8 +174,9 @@ func resourceArmKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetId(*read.ID)
 	} else {
 		parameters := keyvault.SecretUpdateParameters{
-			ContentType: utils.String(contentType),
-			Tags:        expandTags(tags),
+			ContentType:      utils.String(contentType),
+			Tags:             expandTags(tags),
+			SecretAttributes: attributes,
 		}
 
 		if _, err = client.UpdateSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version, parameters); err != nil {
@@ -177,6 +219,19 @@ func resourceArmKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("version", respID.Version)
 	d.Set("content_type", resp.ContentType)
 
+	if attributes := resp.Attributes; attributes != nil {
+		d.Set("not_before_date", flattenKeyVaultChildItemDate(attributes.NotBefore))
+		d.Set("expiration_date", flattenKeyVaultChildItemDate(attributes.Expires))
+	}
+
+	versions, err := keyVaultSecretVersions(ctx, client, respID.KeyVaultBaseUrl, respID.Name)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("Error setting `versions`: %+v", err)
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 	return nil
 }
@@ -190,6 +245,45 @@ func resourceArmKeyVaultSecretDelete(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	_, err = client.DeleteSecret(ctx, id.KeyVaultBaseUrl, id.Name)
-	return err
+	resp, err := client.DeleteSecret(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		return err
+	}
+
+	// the Secret is only soft-deleted when Soft Delete is enabled on the Key Vault
+	if !meta.(*ArmClient).features.keyVault.purgeSoftDeletedSecretsOnDestroy || resp.RecoveryID == nil {
+		return nil
+	}
+
+	if attributes := resp.Attributes; attributes == nil || !keyVaultRecoveryLevelIsPurgeable(string(attributes.RecoveryLevel)) {
+		log.Printf("[DEBUG] Secret %q (Key Vault %q) can't be purged since Purge Protection is enabled", id.Name, id.KeyVaultBaseUrl)
+		return nil
+	}
+
+	return keyVaultSecretSoftDelete(client, id.KeyVaultBaseUrl, id.Name).purgeSoftDeleted(ctx)
+}
+
+func keyVaultSecretSoftDelete(client keyvault.BaseClient, keyVaultBaseUrl string, name string) keyVaultChildItemSoftDelete {
+	return keyVaultChildItemSoftDelete{
+		itemType:        "Secret",
+		featureFlag:     "recover_soft_deleted_secrets",
+		name:            name,
+		keyVaultBaseUrl: keyVaultBaseUrl,
+		get: func(ctx context.Context) (autorest.Response, error) {
+			// "" indicates the latest version
+			resp, err := client.GetSecret(ctx, keyVaultBaseUrl, name, "")
+			return resp.Response, err
+		},
+		getDeleted: func(ctx context.Context) (autorest.Response, error) {
+			resp, err := client.GetDeletedSecret(ctx, keyVaultBaseUrl, name)
+			return resp.Response, err
+		},
+		recover: func(ctx context.Context) error {
+			_, err := client.RecoverDeletedSecret(ctx, keyVaultBaseUrl, name)
+			return err
+		},
+		purge: func(ctx context.Context) (autorest.Response, error) {
+			return client.PurgeDeletedSecret(ctx, keyVaultBaseUrl, name)
+		},
+	}
 }
@@ -95,6 +95,9 @@ func TestAccAzureRMKeyVaultSecret_complete(t *testing.T) {
 					testCheckAzureRMKeyVaultSecretExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.hello", "world"),
+					resource.TestCheckResourceAttr(resourceName, "not_before_date", "2019-01-01T01:02:03Z"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_date", "2030-01-01T01:02:03Z"),
+					resource.TestCheckResourceAttr(resourceName, "versions.#", "1"),
 				),
 			},
 			{
@@ -135,6 +138,39 @@ func TestAccAzureRMKeyVaultSecret_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVaultSecret_softDeleteRecovery(t *testing.T) {
+	resourceName := "azurerm_key_vault_secret.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultSecret_softDeleteRecovery(rs, location, "rick-and-morty", false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "rick-and-morty"),
+				),
+			},
+			{
+				// delete the secret, leaving it soft-deleted
+				Config: testAccAzureRMKeyVaultSecret_softDeleteRecoveryTemplate(rs, location, false),
+			},
+			{
+				// re-creating the secret recovers it and then sets the new value
+				Config: testAccAzureRMKeyVaultSecret_softDeleteRecovery(rs, location, "szechuan", true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "szechuan"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMKeyVaultSecretDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -306,6 +342,9 @@ resource "azurerm_key_vault_secret" "test" {
   vault_uri    = "${azurerm_key_vault.test.vault_uri}"
   content_type = "application/xml"
 
+  not_before_date = "2019-01-01T01:02:03Z"
+  expiration_date = "2030-01-01T01:02:03Z"
+
   tags {
     "hello" = "world"
   }
@@ -359,3 +398,60 @@ resource "azurerm_key_vault_secret" "test" {
 }
 `, rString, location, rString, rString)
 }
+
+func testAccAzureRMKeyVaultSecret_softDeleteRecoveryTemplate(rString string, location string, purgeOnDestroy bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_deleted_secrets_on_destroy = %t
+    }
+  }
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  soft_delete_enabled = true
+
+  sku {
+    name = "premium"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    secret_permissions = [
+      "delete",
+      "get",
+      "purge",
+      "recover",
+      "set",
+    ]
+  }
+}
+`, purgeOnDestroy, rString, location, rString)
+}
+
+func testAccAzureRMKeyVaultSecret_softDeleteRecovery(rString string, location string, value string, purgeOnDestroy bool) string {
+	template := testAccAzureRMKeyVaultSecret_softDeleteRecoveryTemplate(rString, location, purgeOnDestroy)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_secret" "test" {
+  name      = "secret-%s"
+  value     = "%s"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+}
+`, template, rString, value)
+}
@@ -114,6 +114,16 @@ func resourceArmLoadBalancer() *schema.Resource {
 							Set: schema.HashString,
 						},
 
+						"outbound_rules": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+							Set: schema.HashString,
+						},
+
 						"zones": singleZonesSchema(),
 					},
 				},
@@ -363,6 +373,15 @@ func flattenLoadBalancerFrontendIpConfiguration(ipConfigs *[]network.FrontendIPC
 				ipConfig["inbound_nat_rules"] = schema.NewSet(schema.HashString, inboundNatRules)
 
 			}
+
+			if rules := props.OutboundRules; rules != nil {
+				outboundRules := make([]interface{}, 0, len(*rules))
+				for _, rule := range *rules {
+					outboundRules = append(outboundRules, *rule.ID)
+				}
+
+				ipConfig["outbound_rules"] = schema.NewSet(schema.HashString, outboundRules)
+			}
 		}
 
 		result = append(result, ipConfig)
@@ -0,0 +1,363 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLoadBalancerOutboundRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLoadBalancerOutboundRuleCreateUpdate,
+		Read:   resourceArmLoadBalancerOutboundRuleRead,
+		Update: resourceArmLoadBalancerOutboundRuleCreateUpdate,
+		Delete: resourceArmLoadBalancerOutboundRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: loadBalancerSubResourceStateImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"loadbalancer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"frontend_ip_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"backend_address_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"protocol": {
+				Type:             schema.TypeString,
+				Required:         true,
+				StateFunc:        ignoreCaseStateFunc,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Protocol1All),
+					string(network.Protocol1TCP),
+					string(network.Protocol1UDP),
+				}, true),
+			},
+
+			"enable_tcp_reset": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"allocated_outbound_ports": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1024,
+				ValidateFunc: validate.IntBetweenAndDivisibleBy(0, 64000, 8),
+			},
+
+			"idle_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(4, 120),
+			},
+		},
+	}
+}
+
+func resourceArmLoadBalancerOutboundRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).loadBalancerClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	loadBalancerID := d.Get("loadbalancer_id").(string)
+	armMutexKV.Lock(loadBalancerID)
+	defer armMutexKV.Unlock(loadBalancerID)
+
+	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
+	if err != nil {
+		return fmt.Errorf("Error Getting Load Balancer By ID: %+v", err)
+	}
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] Load Balancer %q not found. Removing from state", name)
+		return nil
+	}
+
+	newOutboundRule, err := expandAzureRmLoadBalancerOutboundRule(d, loadBalancer)
+	if err != nil {
+		return fmt.Errorf("Error Expanding Load Balancer Outbound Rule: %+v", err)
+	}
+
+	outboundRules := make([]network.OutboundRule, 0)
+	if rules := loadBalancer.LoadBalancerPropertiesFormat.OutboundRules; rules != nil {
+		outboundRules = *rules
+	}
+
+	existingOutboundRule, existingOutboundRuleIndex, exists := findLoadBalancerOutboundRuleByName(loadBalancer, name)
+	if exists {
+		if name == *existingOutboundRule.Name {
+			// this rule is being updated/reapplied remove old copy from the slice
+			outboundRules = append(outboundRules[:existingOutboundRuleIndex], outboundRules[existingOutboundRuleIndex+1:]...)
+		}
+	}
+
+	outboundRules = append(outboundRules, *newOutboundRule)
+
+	loadBalancer.LoadBalancerPropertiesFormat.OutboundRules = &outboundRules
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
+	if err != nil {
+		return fmt.Errorf("Error Getting Load Balancer Name and Group:: %+v", err)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
+	if err != nil {
+		return fmt.Errorf("Error Creating/Updating Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
+	if err != nil {
+		return fmt.Errorf("Error Getting Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Load Balancer %q (Resource Group %q) ID", loadBalancerName, resGroup)
+	}
+
+	var outboundRuleId string
+	if props := read.LoadBalancerPropertiesFormat; props != nil && props.OutboundRules != nil {
+		for _, rule := range *props.OutboundRules {
+			if rule.Name != nil && *rule.Name == name {
+				outboundRuleId = *rule.ID
+			}
+		}
+	}
+
+	if outboundRuleId == "" {
+		return fmt.Errorf("Cannot find created Load Balancer Outbound Rule ID %q", outboundRuleId)
+	}
+
+	d.SetId(outboundRuleId)
+
+	log.Printf("[DEBUG] Waiting for Load Balancer (%s) to become available", loadBalancerName)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Accepted", "Updating"},
+		Target:  []string{"Succeeded"},
+		Refresh: loadbalancerStateRefreshFunc(ctx, client, resGroup, loadBalancerName),
+		Timeout: 10 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Load Balancer (%s) to become available: %s", loadBalancerName, err)
+	}
+
+	return resourceArmLoadBalancerOutboundRuleRead(d, meta)
+}
+
+func resourceArmLoadBalancerOutboundRuleRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["outboundRules"]
+
+	loadBalancer, exists, err := retrieveLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
+	if err != nil {
+		return fmt.Errorf("Error Getting Load Balancer By ID: %+v", err)
+	}
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] Load Balancer %q not found. Removing from state", name)
+		return nil
+	}
+
+	config, _, exists := findLoadBalancerOutboundRuleByName(loadBalancer, name)
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] Load Balancer Outbound Rule %q not found. Removing from state", name)
+		return nil
+	}
+
+	d.Set("name", config.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if properties := config.OutboundRulePropertiesFormat; properties != nil {
+		d.Set("protocol", properties.Protocol)
+		d.Set("enable_tcp_reset", properties.EnableTCPReset)
+
+		if properties.AllocatedOutboundPorts != nil {
+			d.Set("allocated_outbound_ports", properties.AllocatedOutboundPorts)
+		}
+
+		if properties.IdleTimeoutInMinutes != nil {
+			d.Set("idle_timeout_in_minutes", properties.IdleTimeoutInMinutes)
+		}
+
+		frontendIPConfigurations, err := flattenAzureRmLoadBalancerOutboundRuleFrontendIPConfigurations(properties.FrontendIPConfigurations)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("frontend_ip_configuration", frontendIPConfigurations); err != nil {
+			return fmt.Errorf("Error setting `frontend_ip_configuration`: %+v", err)
+		}
+
+		if properties.BackendAddressPool != nil {
+			d.Set("backend_address_pool_id", properties.BackendAddressPool.ID)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmLoadBalancerOutboundRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).loadBalancerClient
+	ctx := meta.(*ArmClient).StopContext
+
+	loadBalancerID := d.Get("loadbalancer_id").(string)
+	armMutexKV.Lock(loadBalancerID)
+	defer armMutexKV.Unlock(loadBalancerID)
+
+	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
+	if err != nil {
+		return fmt.Errorf("Error Getting Load Balancer By ID: %+v", err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	_, index, exists := findLoadBalancerOutboundRuleByName(loadBalancer, d.Get("name").(string))
+	if !exists {
+		return nil
+	}
+
+	oldOutboundRules := *loadBalancer.LoadBalancerPropertiesFormat.OutboundRules
+	newOutboundRules := append(oldOutboundRules[:index], oldOutboundRules[index+1:]...)
+	loadBalancer.LoadBalancerPropertiesFormat.OutboundRules = &newOutboundRules
+
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
+	if err != nil {
+		return fmt.Errorf("Error Getting Load Balancer Name and Group:: %+v", err)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
+	if err != nil {
+		return fmt.Errorf("Error Creating/Updating Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
+	if err != nil {
+		return fmt.Errorf("Error Getting Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Load Balancer %q (Resource Group %q)", loadBalancerName, resGroup)
+	}
+
+	return nil
+}
+
+func expandAzureRmLoadBalancerOutboundRule(d *schema.ResourceData, lb *network.LoadBalancer) (*network.OutboundRule, error) {
+	properties := network.OutboundRulePropertiesFormat{
+		Protocol:               network.Protocol1(d.Get("protocol").(string)),
+		EnableTCPReset:         utils.Bool(d.Get("enable_tcp_reset").(bool)),
+		AllocatedOutboundPorts: utils.Int32(int32(d.Get("allocated_outbound_ports").(int))),
+		IdleTimeoutInMinutes:   utils.Int32(int32(d.Get("idle_timeout_in_minutes").(int))),
+	}
+
+	frontendIPConfigurations := make([]network.SubResource, 0)
+	for _, raw := range d.Get("frontend_ip_configuration").([]interface{}) {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+
+		config, exists := findLoadBalancerFrontEndIpConfigurationByName(lb, name)
+		if !exists {
+			return nil, fmt.Errorf("[ERROR] Cannot find FrontEnd IP Configuration with the name %s", name)
+		}
+
+		frontendIPConfigurations = append(frontendIPConfigurations, network.SubResource{
+			ID: config.ID,
+		})
+	}
+	properties.FrontendIPConfigurations = &frontendIPConfigurations
+
+	if v := d.Get("backend_address_pool_id").(string); v != "" {
+		properties.BackendAddressPool = &network.SubResource{
+			ID: &v,
+		}
+	}
+
+	return &network.OutboundRule{
+		Name:                         utils.String(d.Get("name").(string)),
+		OutboundRulePropertiesFormat: &properties,
+	}, nil
+}
+
+func flattenAzureRmLoadBalancerOutboundRuleFrontendIPConfigurations(input *[]network.SubResource) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, config := range *input {
+		if config.ID == nil {
+			continue
+		}
+
+		id, err := parseAzureResourceID(*config.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":   *config.ID,
+			"name": id.Path["frontendIPConfigurations"],
+		})
+	}
+
+	return results, nil
+}
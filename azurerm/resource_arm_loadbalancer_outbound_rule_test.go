@@ -0,0 +1,296 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMLoadBalancerOutboundRule_basic(t *testing.T) {
+	var lb network.LoadBalancer
+	ri := acctest.RandInt()
+	outboundRuleName := fmt.Sprintf("OutboundRule-%d", ri)
+
+	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
+	outboundRuleId := fmt.Sprintf(
+		"/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Network/loadBalancers/arm-test-loadbalancer-%d/outboundRules/%s",
+		subscriptionID, ri, ri, outboundRuleName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLoadBalancerOutboundRule_basic(ri, outboundRuleName, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
+					testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName, &lb),
+					resource.TestCheckResourceAttr("azurerm_lb_outbound_rule.test", "id", outboundRuleId),
+					resource.TestCheckResourceAttr("azurerm_lb_outbound_rule.test", "frontend_ip_configuration.#", "1"),
+					resource.TestCheckResourceAttr("azurerm_lb_outbound_rule.test", "allocated_outbound_ports", "1024"),
+					resource.TestCheckResourceAttr("azurerm_lb_outbound_rule.test", "idle_timeout_in_minutes", "4"),
+				),
+			},
+			{
+				ResourceName:      "azurerm_lb_outbound_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLoadBalancerOutboundRule_removal(t *testing.T) {
+	var lb network.LoadBalancer
+	ri := acctest.RandInt()
+	outboundRuleName := fmt.Sprintf("OutboundRule-%d", ri)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLoadBalancerOutboundRule_basic(ri, outboundRuleName, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
+					testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName, &lb),
+				),
+			},
+			{
+				Config: testAccAzureRMLoadBalancerOutboundRule_removal(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
+					testCheckAzureRMLoadBalancerOutboundRuleNotExists(outboundRuleName, &lb),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLoadBalancerOutboundRule_multipleFrontends(t *testing.T) {
+	var lb network.LoadBalancer
+	ri := acctest.RandInt()
+	outboundRuleName := fmt.Sprintf("OutboundRule-%d", ri)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLoadBalancerOutboundRule_basic(ri, outboundRuleName, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
+					testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName, &lb),
+					resource.TestCheckResourceAttr("azurerm_lb_outbound_rule.test", "frontend_ip_configuration.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMLoadBalancerOutboundRule_multipleFrontends(ri, outboundRuleName, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
+					testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName, &lb),
+					resource.TestCheckResourceAttr("azurerm_lb_outbound_rule.test", "frontend_ip_configuration.#", "2"),
+					resource.TestCheckResourceAttr("azurerm_lb_outbound_rule.test", "allocated_outbound_ports", "2048"),
+					resource.TestCheckResourceAttr("azurerm_lb_outbound_rule.test", "idle_timeout_in_minutes", "15"),
+					resource.TestCheckResourceAttr("azurerm_lb_outbound_rule.test", "enable_tcp_reset", "true"),
+					resource.TestCheckResourceAttr("azurerm_lb_rule.test", "disable_outbound_snat", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLoadBalancerOutboundRule_disappears(t *testing.T) {
+	var lb network.LoadBalancer
+	ri := acctest.RandInt()
+	outboundRuleName := fmt.Sprintf("OutboundRule-%d", ri)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLoadBalancerOutboundRule_basic(ri, outboundRuleName, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
+					testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName, &lb),
+					testCheckAzureRMLoadBalancerOutboundRuleDisappears(outboundRuleName, &lb),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName string, lb *network.LoadBalancer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, _, exists := findLoadBalancerOutboundRuleByName(lb, outboundRuleName)
+		if !exists {
+			return fmt.Errorf("An Outbound Rule with name %q cannot be found.", outboundRuleName)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMLoadBalancerOutboundRuleNotExists(outboundRuleName string, lb *network.LoadBalancer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, _, exists := findLoadBalancerOutboundRuleByName(lb, outboundRuleName)
+		if exists {
+			return fmt.Errorf("An Outbound Rule with name %q has been found.", outboundRuleName)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMLoadBalancerOutboundRuleDisappears(outboundRuleName string, lb *network.LoadBalancer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).loadBalancerClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		_, i, exists := findLoadBalancerOutboundRuleByName(lb, outboundRuleName)
+		if !exists {
+			return fmt.Errorf("An Outbound Rule with name %q cannot be found.", outboundRuleName)
+		}
+
+		currentRules := *lb.LoadBalancerPropertiesFormat.OutboundRules
+		rules := append(currentRules[:i], currentRules[i+1:]...)
+		lb.LoadBalancerPropertiesFormat.OutboundRules = &rules
+
+		id, err := parseAzureResourceID(*lb.ID)
+		if err != nil {
+			return err
+		}
+
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, *lb.Name, *lb)
+		if err != nil {
+			return fmt.Errorf("Error Creating/Updating Load Balancer %+v", err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the completion of Load Balancer %+v", err)
+		}
+
+		_, err = client.Get(ctx, id.ResourceGroup, *lb.Name, "")
+		return err
+	}
+}
+
+func testAccAzureRMLoadBalancerOutboundRule_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "test-ip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "static"
+  sku                          = "Standard"
+}
+
+resource "azurerm_public_ip" "test2" {
+  name                         = "test-ip2-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "static"
+  sku                          = "Standard"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "arm-test-loadbalancer-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+
+  frontend_ip_configuration {
+    name                 = "one-%d"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  frontend_ip_configuration {
+    name                 = "two-%d"
+    public_ip_address_id = "${azurerm_public_ip.test2.id}"
+  }
+}
+
+resource "azurerm_lb_backend_address_pool" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  loadbalancer_id     = "${azurerm_lb.test.id}"
+  name                = "be-%d"
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt)
+}
+
+func testAccAzureRMLoadBalancerOutboundRule_basic(rInt int, outboundRuleName string, location string) string {
+	template := testAccAzureRMLoadBalancerOutboundRule_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lb_outbound_rule" "test" {
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  loadbalancer_id         = "${azurerm_lb.test.id}"
+  name                    = "%s"
+  backend_address_pool_id = "${azurerm_lb_backend_address_pool.test.id}"
+  protocol                = "All"
+
+  frontend_ip_configuration {
+    name = "one-%d"
+  }
+}
+`, template, outboundRuleName, rInt)
+}
+
+func testAccAzureRMLoadBalancerOutboundRule_removal(rInt int, location string) string {
+	return testAccAzureRMLoadBalancerOutboundRule_template(rInt, location)
+}
+
+func testAccAzureRMLoadBalancerOutboundRule_multipleFrontends(rInt int, outboundRuleName string, location string) string {
+	template := testAccAzureRMLoadBalancerOutboundRule_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lb_rule" "test" {
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  loadbalancer_id                = "${azurerm_lb.test.id}"
+  name                           = "LbRule-%d"
+  protocol                       = "Tcp"
+  frontend_port                  = 80
+  backend_port                   = 80
+  frontend_ip_configuration_name = "one-%d"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.test.id}"
+  disable_outbound_snat          = true
+}
+
+resource "azurerm_lb_outbound_rule" "test" {
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  loadbalancer_id          = "${azurerm_lb.test.id}"
+  name                     = "%s"
+  backend_address_pool_id  = "${azurerm_lb_backend_address_pool.test.id}"
+  protocol                 = "Tcp"
+  enable_tcp_reset         = true
+  allocated_outbound_ports = 2048
+  idle_timeout_in_minutes  = 15
+
+  frontend_ip_configuration {
+    name = "one-%d"
+  }
+
+  frontend_ip_configuration {
+    name = "two-%d"
+  }
+}
+`, template, rInt, rInt, outboundRuleName, rInt, rInt)
+}
@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -187,12 +188,22 @@ func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) erro
 		createDisk.EncryptionSettings = expandManagedDiskEncryptionSettings(settings)
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, createDisk)
-	if err != nil {
-		return err
+	update := func() error {
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, createDisk)
+		if err != nil {
+			return err
+		}
+
+		return future.WaitForCompletionRef(ctx, client.Client)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	var err error
+	if !d.IsNewResource() && d.HasChange("disk_size_gb") {
+		err = resizeManagedDisk(ctx, meta, resGroup, name, update)
+	} else {
+		err = update()
+	}
+	if err != nil {
 		return err
 	}
 
@@ -209,6 +220,34 @@ func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) erro
 	return resourceArmManagedDiskRead(d, meta)
 }
 
+// resizeManagedDisk resizes the Managed Disk using the specified update function - if the Managed Disk is attached
+// to a Virtual Machine then the Virtual Machine is deallocated whilst the Managed Disk is resized.
+func resizeManagedDisk(ctx context.Context, meta interface{}, resourceGroup string, name string, update func() error) error {
+	client := meta.(*ArmClient).diskClient
+
+	existing, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Managed Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if existing.ManagedBy == nil || *existing.ManagedBy == "" {
+		return update()
+	}
+
+	virtualMachineId, err := parseAzureResourceID(*existing.ManagedBy)
+	if err != nil {
+		return fmt.Errorf("Error parsing Virtual Machine ID %q: %+v", *existing.ManagedBy, err)
+	}
+	virtualMachineResourceGroup := virtualMachineId.ResourceGroup
+	virtualMachineName := virtualMachineId.Path["virtualMachines"]
+
+	azureRMLockByName(virtualMachineName, virtualMachineResourceName)
+	defer azureRMUnlockByName(virtualMachineName, virtualMachineResourceName)
+
+	log.Printf("[DEBUG] Managed Disk %q (Resource Group %q) is attached to Virtual Machine %q (Resource Group %q) - deallocating to resize", name, resourceGroup, virtualMachineName, virtualMachineResourceGroup)
+	return updateVirtualMachineWhilstDeallocated(ctx, meta, virtualMachineResourceGroup, virtualMachineName, update)
+}
+
 func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
 	ctx := meta.(*ArmClient).StopContext
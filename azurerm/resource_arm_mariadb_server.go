@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/mariadb/mgmt/2018-06-01-preview/mariadb"
@@ -99,19 +100,9 @@ func resourceArmMariaDbServer() *schema.Resource {
 				},
 			},
 
-			"administrator_login": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validate.NoEmptyStrings,
-			},
+			"administrator_login": azure.SchemaDatabaseServerAdministratorLogin(),
 
-			"administrator_login_password": {
-				Type:         schema.TypeString,
-				Required:     true,
-				Sensitive:    true,
-				ValidateFunc: validate.NoEmptyStrings,
-			},
+			"administrator_login_password": azure.SchemaDatabaseServerAdministratorLoginPassword(),
 
 			"version": {
 				Type:     schema.TypeString,
@@ -161,6 +152,16 @@ func resourceArmMariaDbServer() *schema.Resource {
 				}, false),
 			},
 
+			"create_mode": azure.SchemaDatabaseServerCreateMode([]string{
+				azure.DatabaseServerCreateModeDefault,
+				azure.DatabaseServerCreateModeGeoRestore,
+				azure.DatabaseServerCreateModePointInTimeRestore,
+			}),
+
+			"creation_source_server_id": azure.SchemaDatabaseServerCreationSourceServerID(),
+
+			"restore_point_in_time": azure.SchemaDatabaseServerRestorePointInTime(),
+
 			"fqdn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -168,6 +169,8 @@ func resourceArmMariaDbServer() *schema.Resource {
 
 			"tags": tagsSchema(),
 		},
+
+		CustomizeDiff: azure.DatabaseServerCreateModeCustomizeDiff,
 	}
 }
 
@@ -181,10 +184,6 @@ func resourceArmMariaDbServerCreateUpdate(d *schema.ResourceData, meta interface
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	adminLogin := d.Get("administrator_login").(string)
-	adminLoginPassword := d.Get("administrator_login_password").(string)
-	sslEnforcement := d.Get("ssl_enforcement").(string)
-	version := d.Get("version").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
 	sku := expandAzureRmMariaDbServerSku(d)
@@ -242,18 +241,13 @@ func resourceArmMariaDbServerCreateUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
+	serverProperties := expandAzureRmMariaDbServerPropertiesForCreate(d)
+
 	properties := mariadb.ServerForCreate{
-		Location: &location,
-		Properties: &mariadb.ServerPropertiesForDefaultCreate{
-			AdministratorLogin:         utils.String(adminLogin),
-			AdministratorLoginPassword: utils.String(adminLoginPassword),
-			Version:                    mariadb.ServerVersion(version),
-			SslEnforcement:             mariadb.SslEnforcementEnum(sslEnforcement),
-			StorageProfile:             storageProfile,
-			CreateMode:                 mariadb.CreateModeDefault,
-		},
-		Sku:  sku,
-		Tags: expandTags(tags),
+		Location:   &location,
+		Properties: serverProperties,
+		Sku:        sku,
+		Tags:       expandTags(tags),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, properties)
@@ -320,6 +314,8 @@ func resourceArmMariaDbServerRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	azure.FlattenDatabaseServerCreateMode(d, nil)
+
 	if err := d.Set("sku", flattenMariaDbServerSku(resp.Sku)); err != nil {
 		return fmt.Errorf("Error setting `sku`: %+v", err)
 	}
@@ -360,6 +356,56 @@ func resourceArmMariaDbServerDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+func expandAzureRmMariaDbServerPropertiesForCreate(d *schema.ResourceData) mariadb.BasicServerPropertiesForCreate {
+	createMode := azure.ExpandDatabaseServerCreateMode(d)
+
+	sslEnforcement := mariadb.SslEnforcementEnum(d.Get("ssl_enforcement").(string))
+	version := mariadb.ServerVersion(d.Get("version").(string))
+	storageProfile := expandAzureRmMariaDbStorageProfile(d)
+
+	// updates are also sent as a Create, so the Create Mode only applies when the server is first created
+	mode := createMode.Mode
+	if !d.IsNewResource() {
+		mode = azure.DatabaseServerCreateModeDefault
+	}
+
+	switch mode {
+	case azure.DatabaseServerCreateModeGeoRestore:
+		return &mariadb.ServerPropertiesForGeoRestore{
+			SourceServerID: utils.String(createMode.SourceServerID),
+			Version:        version,
+			SslEnforcement: sslEnforcement,
+			StorageProfile: storageProfile,
+			CreateMode:     mariadb.CreateModeGeoRestore,
+		}
+
+	case azure.DatabaseServerCreateModePointInTimeRestore:
+		return &mariadb.ServerPropertiesForRestore{
+			SourceServerID:     utils.String(createMode.SourceServerID),
+			RestorePointInTime: createMode.RestorePointInTime,
+			Version:            version,
+			SslEnforcement:     sslEnforcement,
+			StorageProfile:     storageProfile,
+			CreateMode:         mariadb.CreateModePointInTimeRestore,
+		}
+	}
+
+	properties := mariadb.ServerPropertiesForDefaultCreate{
+		AdministratorLogin: utils.String(d.Get("administrator_login").(string)),
+		Version:            version,
+		SslEnforcement:     sslEnforcement,
+		StorageProfile:     storageProfile,
+		CreateMode:         mariadb.CreateModeDefault,
+	}
+
+	// this can't be set as an empty string, so it's omitted when it isn't specified
+	if v := d.Get("administrator_login_password").(string); v != "" {
+		properties.AdministratorLoginPassword = utils.String(v)
+	}
+
+	return &properties
+}
+
 func expandAzureRmMariaDbServerSku(d *schema.ResourceData) *mariadb.Sku {
 	skus := d.Get("sku").([]interface{})
 	sku := skus[0].(map[string]interface{})
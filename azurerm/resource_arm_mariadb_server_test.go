@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -234,6 +235,40 @@ func TestAccAzureRMMariaDbServer_updateSKU(t *testing.T) {
 
 //
 
+func TestAccAzureRMMariaDbServer_createPointInTimeRestore(t *testing.T) {
+	resourceName := "azurerm_mariadb_server.test"
+	restoreResourceName := "azurerm_mariadb_server.restore"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	// the restore point needs to be after the source server has been created and backed up
+	restoreTime := time.Now().Add(15 * time.Minute)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMariaDbServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMariaDbServer_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMariaDbServerExists(resourceName),
+				),
+			},
+			{
+				PreConfig: func() { time.Sleep(time.Until(restoreTime.Add(5 * time.Minute))) },
+				Config:    testAccAzureRMMariaDbServer_createPointInTimeRestore(ri, location, restoreTime.UTC().Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMariaDbServerExists(resourceName),
+					testCheckAzureRMMariaDbServerExists(restoreResourceName),
+					resource.TestCheckResourceAttr(restoreResourceName, "create_mode", "PointInTimeRestore"),
+					resource.TestCheckResourceAttr(restoreResourceName, "administrator_login", "acctestun"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMMariaDbServerExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -521,3 +556,35 @@ resource "azurerm_mariadb_server" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMMariaDbServer_createPointInTimeRestore(rInt int, location string, restoreTime string) string {
+	template := testAccAzureRMMariaDbServer_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mariadb_server" "restore" {
+  name                = "acctestmariadbsvr-%d-restore"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "B_Gen5_2"
+    capacity = 2
+    tier     = "Basic"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb            = 51200
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  version                   = "10.2"
+  ssl_enforcement           = "Enabled"
+  create_mode               = "PointInTimeRestore"
+  creation_source_server_id = "${azurerm_mariadb_server.test.id}"
+  restore_point_in_time     = "%s"
+}
+`, template, rInt, restoreTime)
+}
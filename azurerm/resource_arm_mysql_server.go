@@ -8,6 +8,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -106,17 +107,9 @@ func resourceArmMySqlServer() *schema.Resource {
 				},
 			},
 
-			"administrator_login": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
+			"administrator_login": azure.SchemaDatabaseServerAdministratorLogin(),
 
-			"administrator_login_password": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
-			},
+			"administrator_login_password": azure.SchemaDatabaseServerAdministratorLoginPassword(),
 
 			"version": {
 				Type:     schema.TypeString,
@@ -168,6 +161,17 @@ func resourceArmMySqlServer() *schema.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
+			"create_mode": azure.SchemaDatabaseServerCreateMode([]string{
+				azure.DatabaseServerCreateModeDefault,
+				azure.DatabaseServerCreateModeGeoRestore,
+				azure.DatabaseServerCreateModePointInTimeRestore,
+				azure.DatabaseServerCreateModeReplica,
+			}),
+
+			"creation_source_server_id": azure.SchemaDatabaseServerCreationSourceServerID(),
+
+			"restore_point_in_time": azure.SchemaDatabaseServerRestorePointInTime(),
+
 			"fqdn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -177,6 +181,9 @@ func resourceArmMySqlServer() *schema.Resource {
 		},
 
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if err := azure.DatabaseServerCreateModeCustomizeDiff(diff, v); err != nil {
+				return err
+			}
 
 			tier, _ := diff.GetOk("sku.0.tier")
 			storageMB, _ := diff.GetOk("storage_profile.0.storage_mb")
@@ -200,28 +207,16 @@ func resourceArmMySqlServerCreate(d *schema.ResourceData, meta interface{}) erro
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	adminLogin := d.Get("administrator_login").(string)
-	adminLoginPassword := d.Get("administrator_login_password").(string)
-	sslEnforcement := d.Get("ssl_enforcement").(string)
-	version := d.Get("version").(string)
-	createMode := "Default"
 	tags := d.Get("tags").(map[string]interface{})
 
 	sku := expandMySQLServerSku(d)
-	storageProfile := expandMySQLStorageProfile(d)
+	serverProperties := expandMySQLServerPropertiesForCreate(d)
 
 	properties := mysql.ServerForCreate{
-		Location: &location,
-		Properties: &mysql.ServerPropertiesForDefaultCreate{
-			AdministratorLogin:         utils.String(adminLogin),
-			AdministratorLoginPassword: utils.String(adminLoginPassword),
-			Version:                    mysql.ServerVersion(version),
-			SslEnforcement:             mysql.SslEnforcementEnum(sslEnforcement),
-			StorageProfile:             storageProfile,
-			CreateMode:                 mysql.CreateMode(createMode),
-		},
-		Sku:  sku,
-		Tags: expandTags(tags),
+		Location:   &location,
+		Properties: serverProperties,
+		Sku:        sku,
+		Tags:       expandTags(tags),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, properties)
@@ -256,7 +251,6 @@ func resourceArmMySqlServerUpdate(d *schema.ResourceData, meta interface{}) erro
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	adminLoginPassword := d.Get("administrator_login_password").(string)
 	sslEnforcement := d.Get("ssl_enforcement").(string)
 	version := d.Get("version").(string)
 	sku := expandMySQLServerSku(d)
@@ -265,15 +259,18 @@ func resourceArmMySqlServerUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	properties := mysql.ServerUpdateParameters{
 		ServerUpdateParametersProperties: &mysql.ServerUpdateParametersProperties{
-			StorageProfile:             storageProfile,
-			AdministratorLoginPassword: utils.String(adminLoginPassword),
-			Version:                    mysql.ServerVersion(version),
-			SslEnforcement:             mysql.SslEnforcementEnum(sslEnforcement),
+			StorageProfile: storageProfile,
+			Version:        mysql.ServerVersion(version),
+			SslEnforcement: mysql.SslEnforcementEnum(sslEnforcement),
 		},
 		Sku:  sku,
 		Tags: expandTags(tags),
 	}
 
+	if v := d.Get("administrator_login_password").(string); v != "" {
+		properties.ServerUpdateParametersProperties.AdministratorLoginPassword = utils.String(v)
+	}
+
 	future, err := client.Update(ctx, resourceGroup, name, properties)
 	if err != nil {
 		return fmt.Errorf("Error updating MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -339,6 +336,8 @@ func resourceArmMySqlServerRead(d *schema.ResourceData, meta interface{}) error
 
 	flattenAndSetTags(d, resp.Tags)
 
+	azure.FlattenDatabaseServerCreateMode(d, resp.MasterServerID)
+
 	// Computed
 	d.Set("fqdn", resp.FullyQualifiedDomainName)
 
@@ -368,6 +367,59 @@ func resourceArmMySqlServerDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func expandMySQLServerPropertiesForCreate(d *schema.ResourceData) mysql.BasicServerPropertiesForCreate {
+	createMode := azure.ExpandDatabaseServerCreateMode(d)
+
+	sslEnforcement := mysql.SslEnforcementEnum(d.Get("ssl_enforcement").(string))
+	version := mysql.ServerVersion(d.Get("version").(string))
+	storageProfile := expandMySQLStorageProfile(d)
+
+	switch createMode.Mode {
+	case azure.DatabaseServerCreateModeGeoRestore:
+		return &mysql.ServerPropertiesForGeoRestore{
+			SourceServerID: utils.String(createMode.SourceServerID),
+			Version:        version,
+			SslEnforcement: sslEnforcement,
+			StorageProfile: storageProfile,
+			CreateMode:     mysql.CreateModeGeoRestore,
+		}
+
+	case azure.DatabaseServerCreateModePointInTimeRestore:
+		return &mysql.ServerPropertiesForRestore{
+			SourceServerID:     utils.String(createMode.SourceServerID),
+			RestorePointInTime: createMode.RestorePointInTime,
+			Version:            version,
+			SslEnforcement:     sslEnforcement,
+			StorageProfile:     storageProfile,
+			CreateMode:         mysql.CreateModePointInTimeRestore,
+		}
+
+	case azure.DatabaseServerCreateModeReplica:
+		return &mysql.ServerPropertiesForReplica{
+			SourceServerID: utils.String(createMode.SourceServerID),
+			Version:        version,
+			SslEnforcement: sslEnforcement,
+			StorageProfile: storageProfile,
+			CreateMode:     mysql.CreateModeReplica,
+		}
+	}
+
+	properties := mysql.ServerPropertiesForDefaultCreate{
+		AdministratorLogin: utils.String(d.Get("administrator_login").(string)),
+		Version:            version,
+		SslEnforcement:     sslEnforcement,
+		StorageProfile:     storageProfile,
+		CreateMode:         mysql.CreateModeDefault,
+	}
+
+	// this can't be set as an empty string, so it's omitted when it isn't specified
+	if v := d.Get("administrator_login_password").(string); v != "" {
+		properties.AdministratorLoginPassword = utils.String(v)
+	}
+
+	return &properties
+}
+
 func expandMySQLServerSku(d *schema.ResourceData) *mysql.Sku {
 	skus := d.Get("sku").([]interface{})
 	sku := skus[0].(map[string]interface{})
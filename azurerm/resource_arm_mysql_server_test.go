@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -207,6 +208,73 @@ func TestAccAzureRMMySQLServer_updateSKU(t *testing.T) {
 
 //
 
+func TestAccAzureRMMySQLServer_createPointInTimeRestore(t *testing.T) {
+	resourceName := "azurerm_mysql_server.test"
+	restoreResourceName := "azurerm_mysql_server.restore"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	// the restore point needs to be after the source server has been created and backed up
+	restoreTime := time.Now().Add(15 * time.Minute)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMySQLServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMySQLServer_basicFiveSeven(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySQLServerExists(resourceName),
+				),
+			},
+			{
+				PreConfig: func() { time.Sleep(time.Until(restoreTime.Add(5 * time.Minute))) },
+				Config:    testAccAzureRMMySQLServer_createPointInTimeRestore(ri, location, restoreTime.UTC().Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySQLServerExists(resourceName),
+					testCheckAzureRMMySQLServerExists(restoreResourceName),
+					resource.TestCheckResourceAttr(restoreResourceName, "create_mode", "PointInTimeRestore"),
+					resource.TestCheckResourceAttr(restoreResourceName, "administrator_login", "acctestun"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMySQLServer_createReplica(t *testing.T) {
+	resourceName := "azurerm_mysql_server.test"
+	replicaResourceName := "azurerm_mysql_server.replica"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMySQLServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMySQLServer_createReplica(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySQLServerExists(resourceName),
+					testCheckAzureRMMySQLServerExists(replicaResourceName),
+					resource.TestCheckResourceAttr(replicaResourceName, "create_mode", "Replica"),
+					resource.TestCheckResourceAttr(replicaResourceName, "administrator_login", "acctestun"),
+					resource.TestCheckResourceAttrSet(replicaResourceName, "creation_source_server_id"),
+				),
+			},
+			{
+				ResourceName:      replicaResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"administrator_login_password", // not returned as sensitive
+				},
+			},
+		},
+	})
+}
+
 func testCheckAzureRMMySQLServerExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -459,3 +527,66 @@ resource "azurerm_mysql_server" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMMySQLServer_createPointInTimeRestore(rInt int, location string, restoreTime string) string {
+	template := testAccAzureRMMySQLServer_basicFiveSeven(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_server" "restore" {
+  name                = "acctestmysqlsvr-%d-restore"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "B_Gen5_2"
+    capacity = 2
+    tier     = "Basic"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb            = 51200
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  version                   = "5.7"
+  ssl_enforcement           = "Enabled"
+  create_mode               = "PointInTimeRestore"
+  creation_source_server_id = "${azurerm_mysql_server.test.id}"
+  restore_point_in_time     = "%s"
+}
+`, template, rInt, restoreTime)
+}
+
+func testAccAzureRMMySQLServer_createReplica(rInt int, location string) string {
+	template := testAccAzureRMMySQLServer_generalPurpose(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_server" "replica" {
+  name                = "acctestmysqlsvr-%d-replica"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "GP_Gen4_32"
+    capacity = 32
+    tier     = "GeneralPurpose"
+    family   = "Gen4"
+  }
+
+  storage_profile {
+    storage_mb            = 640000
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  version                   = "5.7"
+  ssl_enforcement           = "Enabled"
+  create_mode               = "Replica"
+  creation_source_server_id = "${azurerm_mysql_server.test.id}"
+}
+`, template, rInt)
+}
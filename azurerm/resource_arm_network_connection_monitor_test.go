@@ -0,0 +1,388 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func testAccAzureRMNetworkConnectionMonitor_addressBasic(t *testing.T) {
+	resourceName := "azurerm_network_connection_monitor.test"
+
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkConnectionMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkConnectionMonitor_addressBasicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkConnectionMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_start", "true"),
+					resource.TestCheckResourceAttr(resourceName, "interval_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.address", "terraform.io"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.port", "80"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkConnectionMonitor_addressComplete(t *testing.T) {
+	resourceName := "azurerm_network_connection_monitor.test"
+
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkConnectionMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkConnectionMonitor_addressCompleteConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkConnectionMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_start", "false"),
+					resource.TestCheckResourceAttr(resourceName, "interval_in_seconds", "30"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.port", "20020"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkConnectionMonitor_addressUpdate(t *testing.T) {
+	resourceName := "azurerm_network_connection_monitor.test"
+
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkConnectionMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkConnectionMonitor_addressBasicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkConnectionMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "interval_in_seconds", "60"),
+				),
+			},
+			{
+				Config: testAccAzureRMNetworkConnectionMonitor_addressUpdatedConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkConnectionMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "interval_in_seconds", "30"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.port", "443"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkConnectionMonitor_vmBasic(t *testing.T) {
+	resourceName := "azurerm_network_connection_monitor.test"
+
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkConnectionMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkConnectionMonitor_vmBasicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkConnectionMonitorExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "destination.0.virtual_machine_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkConnectionMonitor_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_network_connection_monitor.test"
+
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkConnectionMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkConnectionMonitor_addressBasicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkConnectionMonitorExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMNetworkConnectionMonitor_requiresImportConfig(ri, location),
+				ExpectError: testRequiresImportError("azurerm_network_connection_monitor"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNetworkConnectionMonitorExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		watcherName := rs.Primary.Attributes["network_watcher_name"]
+		name := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).connectionMonitorsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, watcherName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Connection Monitor %q (Watcher %q / Resource Group %q) does not exist", name, watcherName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on connectionMonitorsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNetworkConnectionMonitorDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).connectionMonitorsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_network_connection_monitor" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		watcherName := rs.Primary.Attributes["network_watcher_name"]
+		name := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, resourceGroup, watcherName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on connectionMonitorsClient: %+v", err)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("Connection Monitor still exists: %q", name)
+	}
+
+	return nil
+}
+
+func testAccAzureRMNetworkConnectionMonitor_addressBasicConfig(rInt int, location string) string {
+	config := testAzureRMPacketCapture_base(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_connection_monitor" "test" {
+  name                 = "acctestcm-%d"
+  network_watcher_name = "${azurerm_network_watcher.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  location             = "${azurerm_network_watcher.test.location}"
+
+  source {
+    virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  }
+
+  destination {
+    address = "terraform.io"
+    port    = 80
+  }
+
+  depends_on = ["azurerm_virtual_machine_extension.test"]
+}
+`, config, rInt)
+}
+
+func testAccAzureRMNetworkConnectionMonitor_addressUpdatedConfig(rInt int, location string) string {
+	config := testAzureRMPacketCapture_base(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_connection_monitor" "test" {
+  name                 = "acctestcm-%d"
+  network_watcher_name = "${azurerm_network_watcher.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  location             = "${azurerm_network_watcher.test.location}"
+  interval_in_seconds  = 30
+
+  source {
+    virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  }
+
+  destination {
+    address = "terraform.io"
+    port    = 443
+  }
+
+  depends_on = ["azurerm_virtual_machine_extension.test"]
+}
+`, config, rInt)
+}
+
+func testAccAzureRMNetworkConnectionMonitor_addressCompleteConfig(rInt int, location string) string {
+	config := testAzureRMPacketCapture_base(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_connection_monitor" "test" {
+  name                 = "acctestcm-%d"
+  network_watcher_name = "${azurerm_network_watcher.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  location             = "${azurerm_network_watcher.test.location}"
+  auto_start           = false
+  interval_in_seconds  = 30
+
+  source {
+    virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+    port               = 20020
+  }
+
+  destination {
+    address = "terraform.io"
+    port    = 80
+  }
+
+  tags {
+    env = "test"
+  }
+
+  depends_on = ["azurerm_virtual_machine_extension.test"]
+}
+`, config, rInt)
+}
+
+func testAccAzureRMNetworkConnectionMonitor_vmBasicConfig(rInt int, location string) string {
+	config := testAzureRMPacketCapture_base(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface" "dest" {
+  name                = "acctni-dest%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "dynamic"
+  }
+}
+
+resource "azurerm_virtual_machine" "dest" {
+  name                  = "acctvm-dest%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  network_interface_ids = ["${azurerm_network_interface.dest.id}"]
+  vm_size               = "Standard_F2"
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "osdisk-dest"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "hostname-dest%d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+}
+
+resource "azurerm_network_connection_monitor" "test" {
+  name                 = "acctestcm-%d"
+  network_watcher_name = "${azurerm_network_watcher.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  location             = "${azurerm_network_watcher.test.location}"
+
+  source {
+    virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  }
+
+  destination {
+    virtual_machine_id = "${azurerm_virtual_machine.dest.id}"
+    port               = 80
+  }
+
+  depends_on = ["azurerm_virtual_machine_extension.test"]
+}
+`, config, rInt, rInt, rInt, rInt)
+}
+
+func testAccAzureRMNetworkConnectionMonitor_requiresImportConfig(rInt int, location string) string {
+	config := testAccAzureRMNetworkConnectionMonitor_addressBasicConfig(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_connection_monitor" "import" {
+  name                 = "${azurerm_network_connection_monitor.test.name}"
+  network_watcher_name = "${azurerm_network_connection_monitor.test.network_watcher_name}"
+  resource_group_name  = "${azurerm_network_connection_monitor.test.resource_group_name}"
+  location             = "${azurerm_network_connection_monitor.test.location}"
+
+  source {
+    virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  }
+
+  destination {
+    address = "terraform.io"
+    port    = 80
+  }
+
+  depends_on = ["azurerm_virtual_machine_extension.test"]
+}
+`, config)
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var networkWatcherResourceName = "azurerm_network_watcher"
+
 func resourceArmNetworkWatcher() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmNetworkWatcherCreateUpdate,
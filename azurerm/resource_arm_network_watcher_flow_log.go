@@ -0,0 +1,374 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type networkWatcherFlowLogID struct {
+	ResourceGroup          string
+	NetworkWatcherName     string
+	NetworkSecurityGroupID string
+}
+
+func resourceArmNetworkWatcherFlowLog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkWatcherFlowLogCreateUpdate,
+		Read:   resourceArmNetworkWatcherFlowLogRead,
+		Update: resourceArmNetworkWatcherFlowLogCreateUpdate,
+		Delete: resourceArmNetworkWatcherFlowLogDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_watcher_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"network_security_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"retention_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"traffic_analytics": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"workspace_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.UUID,
+						},
+
+						"workspace_region": {
+							Type:             schema.TypeString,
+							Required:         true,
+							StateFunc:        azureRMNormalizeLocation,
+							DiffSuppressFunc: azureRMSuppressLocationDiff,
+						},
+
+						"workspace_resource_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmNetworkWatcherFlowLogCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).watcherClient
+	ctx := meta.(*ArmClient).StopContext
+
+	networkWatcherName := d.Get("network_watcher_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	networkSecurityGroupID := d.Get("network_security_group_id").(string)
+	storageAccountID := d.Get("storage_account_id").(string)
+	enabled := d.Get("enabled").(bool)
+
+	azureRMLockByName(networkWatcherName, networkWatcherResourceName)
+	defer azureRMUnlockByName(networkWatcherName, networkWatcherResourceName)
+
+	parameters := network.FlowLogInformation{
+		TargetResourceID: utils.String(networkSecurityGroupID),
+		FlowLogProperties: &network.FlowLogProperties{
+			StorageID:       utils.String(storageAccountID),
+			Enabled:         utils.Bool(enabled),
+			RetentionPolicy: expandAzureRmNetworkWatcherFlowLogRetentionPolicy(d.Get("retention_policy").([]interface{})),
+		},
+	}
+
+	if _, ok := d.GetOk("traffic_analytics"); ok {
+		parameters.FlowAnalyticsConfiguration = expandAzureRmNetworkWatcherFlowLogTrafficAnalytics(d.Get("traffic_analytics").([]interface{}))
+	} else {
+		// Traffic Analytics isn't disabled when omitted, so this needs to be done explicitly (as in the Delete)
+		parameters.FlowAnalyticsConfiguration = &network.TrafficAnalyticsProperties{
+			NetworkWatcherFlowAnalyticsConfiguration: &network.TrafficAnalyticsConfigurationProperties{
+				Enabled: utils.Bool(false),
+			},
+		}
+	}
+
+	future, err := client.SetFlowLogConfiguration(ctx, resourceGroup, networkWatcherName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error setting Flow Log Configuration for target %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupID, networkWatcherName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of setting Flow Log Configuration for target %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupID, networkWatcherName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, networkWatcherName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Watcher %q (Resource Group %q): %+v", networkWatcherName, resourceGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Network Watcher %q (Resource Group %q) ID", networkWatcherName, resourceGroup)
+	}
+
+	// Flow Logs don't have an ID of their own, so this is a combination of the Network Watcher and the target resource
+	d.SetId(fmt.Sprintf("%s/networkSecurityGroupId%s", *resp.ID, networkSecurityGroupID))
+
+	return resourceArmNetworkWatcherFlowLogRead(d, meta)
+}
+
+func resourceArmNetworkWatcherFlowLogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).watcherClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureRmNetworkWatcherFlowLogID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := network.FlowLogStatusParameters{
+		TargetResourceID: utils.String(id.NetworkSecurityGroupID),
+	}
+	future, err := client.GetFlowLogStatus(ctx, id.ResourceGroup, id.NetworkWatcherName, parameters)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			log.Printf("[WARN] Network Watcher %q (Resource Group %q) was not found - removing Flow Log from state", id.NetworkWatcherName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Flow Log Configuration for target %q (Network Watcher %q / Resource Group %q): %+v", id.NetworkSecurityGroupID, id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for retrieval of Flow Log Configuration for target %q (Network Watcher %q / Resource Group %q): %+v", id.NetworkSecurityGroupID, id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+
+	fli, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Flow Log Configuration for target %q (Network Watcher %q / Resource Group %q): %+v", id.NetworkSecurityGroupID, id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+
+	d.Set("network_watcher_name", id.NetworkWatcherName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("network_security_group_id", fli.TargetResourceID)
+
+	if props := fli.FlowLogProperties; props != nil {
+		d.Set("enabled", props.Enabled)
+		d.Set("storage_account_id", props.StorageID)
+
+		if err := d.Set("retention_policy", flattenAzureRmNetworkWatcherFlowLogRetentionPolicy(props.RetentionPolicy)); err != nil {
+			return fmt.Errorf("Error setting `retention_policy`: %+v", err)
+		}
+	}
+
+	// once disabled the API continues to return the previous Workspace, so this is only surfaced if it's being managed
+	trafficAnalytics := flattenAzureRmNetworkWatcherFlowLogTrafficAnalytics(fli.FlowAnalyticsConfiguration)
+	if _, ok := d.GetOk("traffic_analytics"); !ok {
+		if config := fli.FlowAnalyticsConfiguration; config != nil && config.NetworkWatcherFlowAnalyticsConfiguration != nil {
+			if enabled := config.NetworkWatcherFlowAnalyticsConfiguration.Enabled; enabled == nil || !*enabled {
+				trafficAnalytics = []interface{}{}
+			}
+		}
+	}
+	if err := d.Set("traffic_analytics", trafficAnalytics); err != nil {
+		return fmt.Errorf("Error setting `traffic_analytics`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmNetworkWatcherFlowLogDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).watcherClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureRmNetworkWatcherFlowLogID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.NetworkWatcherName, networkWatcherResourceName)
+	defer azureRMUnlockByName(id.NetworkWatcherName, networkWatcherResourceName)
+
+	// Flow Logs can't be deleted, instead they're disabled - the API requires the Storage Account to be specified regardless
+	parameters := network.FlowLogInformation{
+		TargetResourceID: utils.String(id.NetworkSecurityGroupID),
+		FlowLogProperties: &network.FlowLogProperties{
+			StorageID: utils.String(d.Get("storage_account_id").(string)),
+			Enabled:   utils.Bool(false),
+		},
+		FlowAnalyticsConfiguration: &network.TrafficAnalyticsProperties{
+			NetworkWatcherFlowAnalyticsConfiguration: &network.TrafficAnalyticsConfigurationProperties{
+				Enabled: utils.Bool(false),
+			},
+		},
+	}
+
+	future, err := client.SetFlowLogConfiguration(ctx, id.ResourceGroup, id.NetworkWatcherName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error disabling Flow Log Configuration for target %q (Network Watcher %q / Resource Group %q): %+v", id.NetworkSecurityGroupID, id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of disabling Flow Log Configuration for target %q (Network Watcher %q / Resource Group %q): %+v", id.NetworkSecurityGroupID, id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func parseAzureRmNetworkWatcherFlowLogID(input string) (*networkWatcherFlowLogID, error) {
+	segments := strings.Split(input, "/networkSecurityGroupId")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("Error parsing Network Watcher Flow Log ID %q: expected the format `{networkWatcherId}/networkSecurityGroupId{networkSecurityGroupId}`", input)
+	}
+
+	watcherID, err := parseAzureResourceID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Network Watcher ID %q: %+v", segments[0], err)
+	}
+
+	watcherName := watcherID.Path["networkWatchers"]
+	if watcherName == "" {
+		return nil, fmt.Errorf("Error parsing Network Watcher Flow Log ID %q: `networkWatchers` segment was empty", input)
+	}
+
+	if _, err := parseAzureResourceID(segments[1]); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Security Group ID %q: %+v", segments[1], err)
+	}
+
+	return &networkWatcherFlowLogID{
+		ResourceGroup:          watcherID.ResourceGroup,
+		NetworkWatcherName:     watcherName,
+		NetworkSecurityGroupID: segments[1],
+	}, nil
+}
+
+func expandAzureRmNetworkWatcherFlowLogRetentionPolicy(input []interface{}) *network.RetentionPolicyParameters {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &network.RetentionPolicyParameters{
+		Enabled: utils.Bool(v["enabled"].(bool)),
+		Days:    utils.Int32(int32(v["days"].(int))),
+	}
+}
+
+func flattenAzureRmNetworkWatcherFlowLogRetentionPolicy(input *network.RetentionPolicyParameters) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{})
+
+	if input.Enabled != nil {
+		result["enabled"] = *input.Enabled
+	}
+	if input.Days != nil {
+		result["days"] = int(*input.Days)
+	}
+
+	return []interface{}{result}
+}
+
+func expandAzureRmNetworkWatcherFlowLogTrafficAnalytics(input []interface{}) *network.TrafficAnalyticsProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &network.TrafficAnalyticsProperties{
+		NetworkWatcherFlowAnalyticsConfiguration: &network.TrafficAnalyticsConfigurationProperties{
+			Enabled:             utils.Bool(v["enabled"].(bool)),
+			WorkspaceID:         utils.String(v["workspace_id"].(string)),
+			WorkspaceRegion:     utils.String(azureRMNormalizeLocation(v["workspace_region"].(string))),
+			WorkspaceResourceID: utils.String(v["workspace_resource_id"].(string)),
+		},
+	}
+}
+
+func flattenAzureRmNetworkWatcherFlowLogTrafficAnalytics(input *network.TrafficAnalyticsProperties) []interface{} {
+	if input == nil || input.NetworkWatcherFlowAnalyticsConfiguration == nil {
+		return []interface{}{}
+	}
+
+	config := *input.NetworkWatcherFlowAnalyticsConfiguration
+
+	// the API returns an empty configuration when Traffic Analytics has never been configured
+	if config.WorkspaceID == nil || *config.WorkspaceID == "" {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{})
+
+	if config.Enabled != nil {
+		result["enabled"] = *config.Enabled
+	}
+	if config.WorkspaceID != nil {
+		result["workspace_id"] = *config.WorkspaceID
+	}
+	if config.WorkspaceRegion != nil {
+		result["workspace_region"] = azureRMNormalizeLocation(*config.WorkspaceRegion)
+	}
+	if config.WorkspaceResourceID != nil {
+		result["workspace_resource_id"] = *config.WorkspaceResourceID
+	}
+
+	return []interface{}{result}
+}
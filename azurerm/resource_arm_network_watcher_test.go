@@ -28,6 +28,24 @@ func TestAccAzureRMNetworkWatcher(t *testing.T) {
 			"storageAccountAndLocalDisk": testAccAzureRMPacketCapture_storageAccountAndLocalDisk,
 			"withFilters":                testAccAzureRMPacketCapture_withFilters,
 		},
+		"FlowLog": {
+			"basic":            testAccAzureRMNetworkWatcherFlowLog_basic,
+			"disabled":         testAccAzureRMNetworkWatcherFlowLog_disabled,
+			"trafficAnalytics": testAccAzureRMNetworkWatcherFlowLog_trafficAnalytics,
+		},
+		"ConnectionMonitor": {
+			"addressBasic":    testAccAzureRMNetworkConnectionMonitor_addressBasic,
+			"addressComplete": testAccAzureRMNetworkConnectionMonitor_addressComplete,
+			"addressUpdate":   testAccAzureRMNetworkConnectionMonitor_addressUpdate,
+			"vmBasic":         testAccAzureRMNetworkConnectionMonitor_vmBasic,
+			"requiresImport":  testAccAzureRMNetworkConnectionMonitor_requiresImport,
+		},
+		"DataSource": {
+			"ipFlowVerify":           testAccDataSourceAzureRMNetworkWatcherIpFlowVerify_basic,
+			"nextHop":                testAccDataSourceAzureRMNetworkWatcherNextHop_basic,
+			"effectiveRoutes":        testAccDataSourceAzureRMNetworkInterfaceEffectiveRoutes_basic,
+			"effectiveSecurityRules": testAccDataSourceAzureRMNetworkInterfaceEffectiveSecurityRules_basic,
+		},
 	}
 
 	for group, m := range testCases {
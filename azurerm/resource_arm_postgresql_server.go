@@ -5,6 +5,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 
@@ -108,17 +109,9 @@ func resourceArmPostgreSQLServer() *schema.Resource {
 				},
 			},
 
-			"administrator_login": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
+			"administrator_login": azure.SchemaDatabaseServerAdministratorLogin(),
 
-			"administrator_login_password": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
-			},
+			"administrator_login_password": azure.SchemaDatabaseServerAdministratorLoginPassword(),
 
 			"version": {
 				Type:     schema.TypeString,
@@ -174,6 +167,16 @@ func resourceArmPostgreSQLServer() *schema.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
+			"create_mode": azure.SchemaDatabaseServerCreateMode([]string{
+				azure.DatabaseServerCreateModeDefault,
+				azure.DatabaseServerCreateModeGeoRestore,
+				azure.DatabaseServerCreateModePointInTimeRestore,
+			}),
+
+			"creation_source_server_id": azure.SchemaDatabaseServerCreationSourceServerID(),
+
+			"restore_point_in_time": azure.SchemaDatabaseServerRestorePointInTime(),
+
 			"fqdn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -183,6 +186,9 @@ func resourceArmPostgreSQLServer() *schema.Resource {
 		},
 
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if err := azure.DatabaseServerCreateModeCustomizeDiff(diff, v); err != nil {
+				return err
+			}
 
 			tier, _ := diff.GetOk("sku.0.tier")
 			storageMB, _ := diff.GetOk("storage_profile.0.storage_mb")
@@ -206,28 +212,16 @@ func resourceArmPostgreSQLServerCreate(d *schema.ResourceData, meta interface{})
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	adminLogin := d.Get("administrator_login").(string)
-	adminLoginPassword := d.Get("administrator_login_password").(string)
-	sslEnforcement := d.Get("ssl_enforcement").(string)
-	version := d.Get("version").(string)
-	createMode := "Default"
 	tags := d.Get("tags").(map[string]interface{})
 
 	sku := expandAzureRmPostgreSQLServerSku(d)
-	storageProfile := expandAzureRmPostgreSQLStorageProfile(d)
+	serverProperties := expandAzureRmPostgreSQLServerPropertiesForCreate(d)
 
 	properties := postgresql.ServerForCreate{
-		Location: &location,
-		Properties: &postgresql.ServerPropertiesForDefaultCreate{
-			AdministratorLogin:         utils.String(adminLogin),
-			AdministratorLoginPassword: utils.String(adminLoginPassword),
-			Version:                    postgresql.ServerVersion(version),
-			SslEnforcement:             postgresql.SslEnforcementEnum(sslEnforcement),
-			StorageProfile:             storageProfile,
-			CreateMode:                 postgresql.CreateMode(createMode),
-		},
-		Sku:  sku,
-		Tags: expandTags(tags),
+		Location:   &location,
+		Properties: serverProperties,
+		Sku:        sku,
+		Tags:       expandTags(tags),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, properties)
@@ -262,7 +256,6 @@ func resourceArmPostgreSQLServerUpdate(d *schema.ResourceData, meta interface{})
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	adminLoginPassword := d.Get("administrator_login_password").(string)
 	sslEnforcement := d.Get("ssl_enforcement").(string)
 	version := d.Get("version").(string)
 	sku := expandAzureRmPostgreSQLServerSku(d)
@@ -271,15 +264,18 @@ func resourceArmPostgreSQLServerUpdate(d *schema.ResourceData, meta interface{})
 
 	properties := postgresql.ServerUpdateParameters{
 		ServerUpdateParametersProperties: &postgresql.ServerUpdateParametersProperties{
-			StorageProfile:             storageProfile,
-			AdministratorLoginPassword: utils.String(adminLoginPassword),
-			Version:                    postgresql.ServerVersion(version),
-			SslEnforcement:             postgresql.SslEnforcementEnum(sslEnforcement),
+			StorageProfile: storageProfile,
+			Version:        postgresql.ServerVersion(version),
+			SslEnforcement: postgresql.SslEnforcementEnum(sslEnforcement),
 		},
 		Sku:  sku,
 		Tags: expandTags(tags),
 	}
 
+	if v := d.Get("administrator_login_password").(string); v != "" {
+		properties.ServerUpdateParametersProperties.AdministratorLoginPassword = utils.String(v)
+	}
+
 	future, err := client.Update(ctx, resourceGroup, name, properties)
 	if err != nil {
 		return fmt.Errorf("Error updating PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -346,6 +342,8 @@ func resourceArmPostgreSQLServerRead(d *schema.ResourceData, meta interface{}) e
 
 	flattenAndSetTags(d, resp.Tags)
 
+	azure.FlattenDatabaseServerCreateMode(d, nil)
+
 	// Computed
 	d.Set("fqdn", resp.FullyQualifiedDomainName)
 
@@ -383,6 +381,50 @@ func resourceArmPostgreSQLServerDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func expandAzureRmPostgreSQLServerPropertiesForCreate(d *schema.ResourceData) postgresql.BasicServerPropertiesForCreate {
+	createMode := azure.ExpandDatabaseServerCreateMode(d)
+
+	sslEnforcement := postgresql.SslEnforcementEnum(d.Get("ssl_enforcement").(string))
+	version := postgresql.ServerVersion(d.Get("version").(string))
+	storageProfile := expandAzureRmPostgreSQLStorageProfile(d)
+
+	switch createMode.Mode {
+	case azure.DatabaseServerCreateModeGeoRestore:
+		return &postgresql.ServerPropertiesForGeoRestore{
+			SourceServerID: utils.String(createMode.SourceServerID),
+			Version:        version,
+			SslEnforcement: sslEnforcement,
+			StorageProfile: storageProfile,
+			CreateMode:     postgresql.CreateModeGeoRestore,
+		}
+
+	case azure.DatabaseServerCreateModePointInTimeRestore:
+		return &postgresql.ServerPropertiesForRestore{
+			SourceServerID:     utils.String(createMode.SourceServerID),
+			RestorePointInTime: createMode.RestorePointInTime,
+			Version:            version,
+			SslEnforcement:     sslEnforcement,
+			StorageProfile:     storageProfile,
+			CreateMode:         postgresql.CreateModePointInTimeRestore,
+		}
+	}
+
+	properties := postgresql.ServerPropertiesForDefaultCreate{
+		AdministratorLogin: utils.String(d.Get("administrator_login").(string)),
+		Version:            version,
+		SslEnforcement:     sslEnforcement,
+		StorageProfile:     storageProfile,
+		CreateMode:         postgresql.CreateModeDefault,
+	}
+
+	// this can't be set as an empty string, so it's omitted when it isn't specified
+	if v := d.Get("administrator_login_password").(string); v != "" {
+		properties.AdministratorLoginPassword = utils.String(v)
+	}
+
+	return &properties
+}
+
 func expandAzureRmPostgreSQLServerSku(d *schema.ResourceData) *postgresql.Sku {
 	skus := d.Get("sku").([]interface{})
 	sku := skus[0].(map[string]interface{})
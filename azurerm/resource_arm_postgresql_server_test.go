@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -295,6 +296,40 @@ func TestAccAzureRMPostgreSQLServer_updateSKU(t *testing.T) {
 
 //
 
+func TestAccAzureRMPostgreSQLServer_createPointInTimeRestore(t *testing.T) {
+	resourceName := "azurerm_postgresql_server.test"
+	restoreResourceName := "azurerm_postgresql_server.restore"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	// the restore point needs to be after the source server has been created and backed up
+	restoreTime := time.Now().Add(15 * time.Minute)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPostgreSQLServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPostgreSQLServer_basicNinePointSix(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPostgreSQLServerExists(resourceName),
+				),
+			},
+			{
+				PreConfig: func() { time.Sleep(time.Until(restoreTime.Add(5 * time.Minute))) },
+				Config:    testAccAzureRMPostgreSQLServer_createPointInTimeRestore(ri, location, restoreTime.UTC().Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPostgreSQLServerExists(resourceName),
+					testCheckAzureRMPostgreSQLServerExists(restoreResourceName),
+					resource.TestCheckResourceAttr(restoreResourceName, "create_mode", "PointInTimeRestore"),
+					resource.TestCheckResourceAttr(restoreResourceName, "administrator_login", "acctestun"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMPostgreSQLServerExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -649,3 +684,35 @@ resource "azurerm_postgresql_server" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMPostgreSQLServer_createPointInTimeRestore(rInt int, location string, restoreTime string) string {
+	template := testAccAzureRMPostgreSQLServer_basicNinePointSix(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_server" "restore" {
+  name                = "acctestpsqlsvr-%d-restore"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "B_Gen4_2"
+    capacity = 2
+    tier     = "Basic"
+    family   = "Gen4"
+  }
+
+  storage_profile {
+    storage_mb            = 51200
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  version                   = "9.6"
+  ssl_enforcement           = "Enabled"
+  create_mode               = "PointInTimeRestore"
+  creation_source_server_id = "${azurerm_postgresql_server.test.id}"
+  restore_point_in_time     = "%s"
+}
+`, template, rInt, restoreTime)
+}
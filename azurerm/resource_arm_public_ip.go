@@ -8,6 +8,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -102,6 +103,13 @@ func resourceArmPublicIp() *schema.Resource {
 				Optional: true,
 			},
 
+			"public_ip_prefix_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
 			"fqdn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -161,6 +169,12 @@ func resourceArmPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
 		Zones: zones,
 	}
 
+	if v, ok := d.GetOk("public_ip_prefix_id"); ok {
+		publicIp.PublicIPAddressPropertiesFormat.PublicIPPrefix = &network.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
 	dnl, dnlOk := d.GetOk("domain_name_label")
 	rfqdn, rfqdnOk := d.GetOk("reverse_fqdn")
 
@@ -245,6 +259,10 @@ func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 
 		d.Set("ip_address", props.IPAddress)
 		d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
+
+		if prefix := props.PublicIPPrefix; prefix != nil {
+			d.Set("public_ip_prefix_id", prefix.ID)
+		}
 	}
 
 	flattenAndSetTags(d, resp.Tags)
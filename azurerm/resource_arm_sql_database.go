@@ -9,6 +9,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	MsSql "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -290,6 +291,28 @@ func resourceArmSqlDatabase() *schema.Resource {
 				},
 			},
 
+			"short_term_retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(7, 35),
+			},
+
+			"long_term_retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recovery_services_backup_policy_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 
@@ -448,6 +471,38 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error setting database threat detection policy: %+v", err)
 	}
 
+	if d.HasChange("short_term_retention_days") {
+		shortTermRetentionClient := meta.(*ArmClient).msSqlBackupShortTermRetentionPoliciesClient
+		shortTermRetention := MsSql.BackupShortTermRetentionPolicy{
+			BackupShortTermRetentionPolicyProperties: &MsSql.BackupShortTermRetentionPolicyProperties{
+				RetentionDays: utils.Int32(int32(d.Get("short_term_retention_days").(int))),
+			},
+		}
+
+		shortTermFuture, err := shortTermRetentionClient.CreateOrUpdate(ctx, resourceGroup, serverName, name, shortTermRetention)
+		if err != nil {
+			return fmt.Errorf("Error setting Short Term Retention Policy for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+
+		if err = shortTermFuture.WaitForCompletionRef(ctx, shortTermRetentionClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for Short Term Retention Policy for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+	}
+
+	if d.HasChange("long_term_retention_policy") {
+		longTermRetentionClient := meta.(*ArmClient).sqlBackupLongTermRetentionPoliciesClient
+		longTermRetention := expandArmSqlDatabaseLongTermRetentionPolicy(d)
+
+		longTermFuture, err := longTermRetentionClient.CreateOrUpdate(ctx, resourceGroup, serverName, name, longTermRetention)
+		if err != nil {
+			return fmt.Errorf("Error setting Long Term Retention Policy for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+
+		if err = longTermFuture.WaitForCompletionRef(ctx, longTermRetentionClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for Long Term Retention Policy for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+	}
+
 	return resourceArmSqlDatabaseRead(d, meta)
 }
 
@@ -484,6 +539,33 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	// the Retention Policies aren't available for all Editions (e.g. Data Warehouses), in which case
+	// the API returns either a Not Found or a Bad Request - and so there's nothing to set
+	shortTermRetentionClient := meta.(*ArmClient).msSqlBackupShortTermRetentionPoliciesClient
+	shortTermRetention, err := shortTermRetentionClient.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(shortTermRetention.Response) && !utils.ResponseWasBadRequest(shortTermRetention.Response) {
+			return fmt.Errorf("Error retrieving Short Term Retention Policy for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+	} else if props := shortTermRetention.BackupShortTermRetentionPolicyProperties; props != nil {
+		d.Set("short_term_retention_days", props.RetentionDays)
+	}
+
+	// Long Term Retention is backed by a Recovery Services Vault, so it's only read for Databases which have it configured
+	if v, ok := d.GetOk("long_term_retention_policy"); ok && len(v.([]interface{})) > 0 {
+		longTermRetentionClient := meta.(*ArmClient).sqlBackupLongTermRetentionPoliciesClient
+		longTermRetention, err := longTermRetentionClient.Get(ctx, resourceGroup, serverName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(longTermRetention.Response) && !utils.ResponseWasBadRequest(longTermRetention.Response) {
+				return fmt.Errorf("Error retrieving Long Term Retention Policy for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+			}
+
+			d.Set("long_term_retention_policy", []interface{}{})
+		} else if err := d.Set("long_term_retention_policy", flattenArmSqlDatabaseLongTermRetentionPolicy(longTermRetention)); err != nil {
+			return fmt.Errorf("Error setting `long_term_retention_policy`: %+v", err)
+		}
+	}
+
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resourceGroup)
 	if location := resp.Location; location != nil {
@@ -689,3 +771,48 @@ func expandArmSqlServerThreatDetectionPolicy(d *schema.ResourceData, location st
 
 	return &policy, nil
 }
+
+func expandArmSqlDatabaseLongTermRetentionPolicy(d *schema.ResourceData) sql.BackupLongTermRetentionPolicy {
+	policies := d.Get("long_term_retention_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		// the Long Term Retention Policy can't be removed, only disabled - which requires the previous Backup Policy ID
+		old, _ := d.GetChange("long_term_retention_policy")
+		backupPolicyId := ""
+		if oldPolicies := old.([]interface{}); len(oldPolicies) > 0 && oldPolicies[0] != nil {
+			backupPolicyId = oldPolicies[0].(map[string]interface{})["recovery_services_backup_policy_id"].(string)
+		}
+
+		return sql.BackupLongTermRetentionPolicy{
+			BackupLongTermRetentionPolicyProperties: &sql.BackupLongTermRetentionPolicyProperties{
+				State:                                  sql.Disabled,
+				RecoveryServicesBackupPolicyResourceID: utils.String(backupPolicyId),
+			},
+		}
+	}
+
+	policy := policies[0].(map[string]interface{})
+	return sql.BackupLongTermRetentionPolicy{
+		BackupLongTermRetentionPolicyProperties: &sql.BackupLongTermRetentionPolicyProperties{
+			State:                                  sql.Enabled,
+			RecoveryServicesBackupPolicyResourceID: utils.String(policy["recovery_services_backup_policy_id"].(string)),
+		},
+	}
+}
+
+func flattenArmSqlDatabaseLongTermRetentionPolicy(input sql.BackupLongTermRetentionPolicy) []interface{} {
+	props := input.BackupLongTermRetentionPolicyProperties
+	if props == nil || props.State != sql.Enabled {
+		return []interface{}{}
+	}
+
+	backupPolicyId := ""
+	if props.RecoveryServicesBackupPolicyResourceID != nil {
+		backupPolicyId = *props.RecoveryServicesBackupPolicyResourceID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"recovery_services_backup_policy_id": backupPolicyId,
+		},
+	}
+}
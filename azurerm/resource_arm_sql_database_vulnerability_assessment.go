@@ -0,0 +1,262 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	sqlPreview "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-03-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSqlDatabaseVulnerabilityAssessment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSqlDatabaseVulnerabilityAssessmentCreateUpdate,
+		Read:   resourceArmSqlDatabaseVulnerabilityAssessmentRead,
+		Update: resourceArmSqlDatabaseVulnerabilityAssessmentCreateUpdate,
+		Delete: resourceArmSqlDatabaseVulnerabilityAssessmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"storage_container_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.URLIsHTTPS,
+			},
+
+			// the access keys aren't returned by the API, so they're only available from the config
+			"storage_account_access_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"storage_container_sas_key"},
+			},
+
+			"storage_container_sas_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"storage_account_access_key"},
+			},
+
+			"recurring_scans": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"email_subscription_admins": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"emails": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmSqlDatabaseVulnerabilityAssessmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlDatabaseVulnerabilityAssessmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	log.Printf("[INFO] preparing arguments for Vulnerability Assessment for SQL Database %q (SQL Server %q / Resource Group %q)", databaseName, serverName, resourceGroup)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Vulnerability Assessment for SQL Database %q (SQL Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+			}
+		}
+
+		// an unconfigured Vulnerability Assessment is returned without a Storage Container
+		if props := existing.DatabaseVulnerabilityAssessmentProperties; props != nil && props.StorageContainerPath != nil && *props.StorageContainerPath != "" && existing.ID != nil {
+			return tf.ImportAsExistsError("azurerm_sql_database_vulnerability_assessment", *existing.ID)
+		}
+	}
+
+	props := sqlPreview.DatabaseVulnerabilityAssessmentProperties{
+		StorageContainerPath: utils.String(d.Get("storage_container_path").(string)),
+		RecurringScans:       expandSqlDatabaseVulnerabilityAssessmentRecurringScans(d.Get("recurring_scans").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("storage_account_access_key"); ok {
+		props.StorageAccountAccessKey = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("storage_container_sas_key"); ok {
+		props.StorageContainerSasKey = utils.String(v.(string))
+	}
+
+	parameters := sqlPreview.DatabaseVulnerabilityAssessment{
+		DatabaseVulnerabilityAssessmentProperties: &props,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, databaseName, parameters); err != nil {
+		return fmt.Errorf("Error setting Vulnerability Assessment for SQL Database %q (SQL Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Vulnerability Assessment for SQL Database %q (SQL Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Vulnerability Assessment for SQL Database %q (SQL Server %q / Resource Group %q)", databaseName, serverName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSqlDatabaseVulnerabilityAssessmentRead(d, meta)
+}
+
+func resourceArmSqlDatabaseVulnerabilityAssessmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlDatabaseVulnerabilityAssessmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Vulnerability Assessment for SQL Database %q (SQL Server %q / Resource Group %q) was not found - removing from state", databaseName, serverName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Vulnerability Assessment for SQL Database %q (SQL Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+	d.Set("database_name", databaseName)
+
+	if props := resp.DatabaseVulnerabilityAssessmentProperties; props != nil {
+		d.Set("storage_container_path", props.StorageContainerPath)
+
+		if err := d.Set("recurring_scans", flattenSqlDatabaseVulnerabilityAssessmentRecurringScans(props.RecurringScans)); err != nil {
+			return fmt.Errorf("Error setting `recurring_scans`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmSqlDatabaseVulnerabilityAssessmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlDatabaseVulnerabilityAssessmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+
+	resp, err := client.Delete(ctx, resourceGroup, serverName, databaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Vulnerability Assessment for SQL Database %q (SQL Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandSqlDatabaseVulnerabilityAssessmentRecurringScans(input []interface{}) *sqlPreview.VulnerabilityAssessmentRecurringScansProperties {
+	if len(input) == 0 || input[0] == nil {
+		return &sqlPreview.VulnerabilityAssessmentRecurringScansProperties{
+			IsEnabled: utils.Bool(false),
+		}
+	}
+
+	scans := input[0].(map[string]interface{})
+
+	emails := make([]string, 0)
+	for _, v := range scans["emails"].([]interface{}) {
+		emails = append(emails, v.(string))
+	}
+
+	return &sqlPreview.VulnerabilityAssessmentRecurringScansProperties{
+		IsEnabled:               utils.Bool(scans["enabled"].(bool)),
+		EmailSubscriptionAdmins: utils.Bool(scans["email_subscription_admins"].(bool)),
+		Emails:                  &emails,
+	}
+}
+
+func flattenSqlDatabaseVulnerabilityAssessmentRecurringScans(input *sqlPreview.VulnerabilityAssessmentRecurringScansProperties) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	result := make(map[string]interface{})
+
+	if input.IsEnabled != nil {
+		result["enabled"] = *input.IsEnabled
+	}
+	if input.EmailSubscriptionAdmins != nil {
+		result["email_subscription_admins"] = *input.EmailSubscriptionAdmins
+	}
+
+	emails := make([]interface{}, 0)
+	if input.Emails != nil {
+		for _, email := range *input.Emails {
+			emails = append(emails, email)
+		}
+	}
+	result["emails"] = emails
+
+	return []interface{}{result}
+}
@@ -0,0 +1,218 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSqlDatabaseVulnerabilityAssessment_basic(t *testing.T) {
+	resourceName := "azurerm_sql_database_vulnerability_assessment.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseVulnerabilityAssessmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlDatabaseVulnerabilityAssessment_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseVulnerabilityAssessmentExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "storage_container_path"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"storage_account_access_key"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlDatabaseVulnerabilityAssessment_recurringScans(t *testing.T) {
+	resourceName := "azurerm_sql_database_vulnerability_assessment.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseVulnerabilityAssessmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlDatabaseVulnerabilityAssessment_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseVulnerabilityAssessmentExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMSqlDatabaseVulnerabilityAssessment_recurringScans(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseVulnerabilityAssessmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "recurring_scans.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "recurring_scans.0.email_subscription_admins", "true"),
+					resource.TestCheckResourceAttr(resourceName, "recurring_scans.0.emails.#", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"storage_account_access_key"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSqlDatabaseVulnerabilityAssessmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).sqlDatabaseVulnerabilityAssessmentsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Vulnerability Assessment for SQL Database %q (SQL Server %q / Resource Group %q) does not exist", databaseName, serverName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on sqlDatabaseVulnerabilityAssessmentsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSqlDatabaseVulnerabilityAssessmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).sqlDatabaseVulnerabilityAssessmentsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_sql_database_vulnerability_assessment" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return fmt.Errorf("Bad: Get on sqlDatabaseVulnerabilityAssessmentsClient: %+v", err)
+		}
+
+		if props := resp.DatabaseVulnerabilityAssessmentProperties; props != nil && props.StorageContainerPath != nil && *props.StorageContainerPath != "" {
+			return fmt.Errorf("Vulnerability Assessment for SQL Database %q (SQL Server %q / Resource Group %q) still exists", databaseName, serverName, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMSqlDatabaseVulnerabilityAssessment_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMSqlDatabaseVulnerabilityAssessment_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_database_vulnerability_assessment" "test" {
+  resource_group_name        = "${azurerm_resource_group.test.name}"
+  server_name                = "${azurerm_sql_server.test.name}"
+  database_name              = "${azurerm_sql_database.test.name}"
+  storage_container_path     = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}/"
+  storage_account_access_key = "${azurerm_storage_account.test.primary_access_key}"
+}
+`, template)
+}
+
+func testAccAzureRMSqlDatabaseVulnerabilityAssessment_recurringScans(rInt int, rString string, location string) string {
+	template := testAccAzureRMSqlDatabaseVulnerabilityAssessment_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_database_vulnerability_assessment" "test" {
+  resource_group_name        = "${azurerm_resource_group.test.name}"
+  server_name                = "${azurerm_sql_server.test.name}"
+  database_name              = "${azurerm_sql_database.test.name}"
+  storage_container_path     = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}/"
+  storage_account_access_key = "${azurerm_storage_account.test.primary_access_key}"
+
+  recurring_scans {
+    enabled                   = true
+    email_subscription_admins = true
+    emails                    = ["email@example1.com", "email@example2.com"]
+  }
+}
+`, template)
+}
+
+func testAccAzureRMSqlDatabaseVulnerabilityAssessment_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vulnerability-assessment"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+
+  threat_detection_policy {
+    state                      = "Enabled"
+    storage_account_access_key = "${azurerm_storage_account.test.primary_access_key}"
+    storage_endpoint           = "${azurerm_storage_account.test.primary_blob_endpoint}"
+  }
+}
+`, rInt, location, rString, rInt, rInt)
+}
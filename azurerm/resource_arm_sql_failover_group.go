@@ -0,0 +1,418 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSqlFailoverGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSqlFailoverGroupCreateUpdate,
+		Read:   resourceArmSqlFailoverGroupRead,
+		Update: resourceArmSqlFailoverGroupCreateUpdate,
+		Delete: resourceArmSqlFailoverGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlFailoverGroupName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"databases": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			"partner_servers": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"readonly_endpoint_failover_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.ReadOnlyEndpointFailoverPolicyDisabled),
+								string(sql.ReadOnlyEndpointFailoverPolicyEnabled),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"read_write_endpoint_failover_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.Automatic),
+								string(sql.Manual),
+							}, false),
+						},
+
+						"grace_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+					},
+				},
+			},
+
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			policies := diff.Get("read_write_endpoint_failover_policy").([]interface{})
+			if len(policies) == 0 || policies[0] == nil {
+				return nil
+			}
+
+			policy := policies[0].(map[string]interface{})
+			mode := policy["mode"].(string)
+			graceMinutes := policy["grace_minutes"].(int)
+
+			if mode == string(sql.Automatic) && graceMinutes == 0 {
+				return fmt.Errorf("`grace_minutes` is required when the `read_write_endpoint_failover_policy` `mode` is `Automatic`")
+			}
+
+			if mode == string(sql.Manual) && graceMinutes != 0 {
+				return fmt.Errorf("`grace_minutes` can only be specified when the `read_write_endpoint_failover_policy` `mode` is `Automatic`")
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmSqlFailoverGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlFailoverGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for SQL Failover Group creation.")
+
+	name := d.Get("name").(string)
+	serverName := d.Get("server_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, serverName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_sql_failover_group", *existing.ID)
+		}
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := sql.FailoverGroup{
+		FailoverGroupProperties: &sql.FailoverGroupProperties{
+			ReadOnlyEndpoint:  expandArmSqlFailoverGroupReadOnlyPolicy(d),
+			ReadWriteEndpoint: expandArmSqlFailoverGroupReadWritePolicy(d),
+			PartnerServers:    expandArmSqlFailoverGroupPartnerServers(d),
+			Databases:         expandArmSqlFailoverGroupDatabases(d),
+		},
+		Tags: expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID of SQL Failover Group %q (Server %q / Resource Group %q)", name, serverName, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmSqlFailoverGroupRead(d, meta)
+}
+
+func resourceArmSqlFailoverGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlFailoverGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["failoverGroups"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] SQL Failover Group %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.FailoverGroupProperties; props != nil {
+		if err := d.Set("read_write_endpoint_failover_policy", flattenArmSqlFailoverGroupReadWritePolicy(props.ReadWriteEndpoint)); err != nil {
+			return fmt.Errorf("Error setting `read_write_endpoint_failover_policy`: %+v", err)
+		}
+
+		if err := d.Set("readonly_endpoint_failover_policy", flattenArmSqlFailoverGroupReadOnlyPolicy(props.ReadOnlyEndpoint)); err != nil {
+			return fmt.Errorf("Error setting `readonly_endpoint_failover_policy`: %+v", err)
+		}
+
+		if err := d.Set("databases", flattenArmSqlFailoverGroupDatabases(props.Databases)); err != nil {
+			return fmt.Errorf("Error setting `databases`: %+v", err)
+		}
+
+		if err := d.Set("partner_servers", flattenArmSqlFailoverGroupPartnerServers(props.PartnerServers)); err != nil {
+			return fmt.Errorf("Error setting `partner_servers`: %+v", err)
+		}
+
+		d.Set("role", string(props.ReplicationRole))
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmSqlFailoverGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlFailoverGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["failoverGroups"]
+
+	future, err := client.Delete(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of SQL Failover Group %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmSqlFailoverGroupReadOnlyPolicy(d *schema.ResourceData) *sql.FailoverGroupReadOnlyEndpoint {
+	policies := d.Get("readonly_endpoint_failover_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		return nil
+	}
+
+	policy := policies[0].(map[string]interface{})
+	return &sql.FailoverGroupReadOnlyEndpoint{
+		FailoverPolicy: sql.ReadOnlyEndpointFailoverPolicy(policy["mode"].(string)),
+	}
+}
+
+func flattenArmSqlFailoverGroupReadOnlyPolicy(input *sql.FailoverGroupReadOnlyEndpoint) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode": string(input.FailoverPolicy),
+		},
+	}
+}
+
+func expandArmSqlFailoverGroupReadWritePolicy(d *schema.ResourceData) *sql.FailoverGroupReadWriteEndpoint {
+	policies := d.Get("read_write_endpoint_failover_policy").([]interface{})
+	policy := policies[0].(map[string]interface{})
+
+	result := sql.FailoverGroupReadWriteEndpoint{
+		FailoverPolicy: sql.ReadWriteEndpointFailoverPolicy(policy["mode"].(string)),
+	}
+
+	if graceMinutes := policy["grace_minutes"].(int); graceMinutes > 0 {
+		result.FailoverWithDataLossGracePeriodMinutes = utils.Int32(int32(graceMinutes))
+	}
+
+	return &result
+}
+
+func flattenArmSqlFailoverGroupReadWritePolicy(input *sql.FailoverGroupReadWriteEndpoint) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	policy := map[string]interface{}{
+		"mode": string(input.FailoverPolicy),
+	}
+
+	if input.FailoverWithDataLossGracePeriodMinutes != nil {
+		policy["grace_minutes"] = int(*input.FailoverWithDataLossGracePeriodMinutes)
+	}
+
+	return []interface{}{policy}
+}
+
+func expandArmSqlFailoverGroupDatabases(d *schema.ResourceData) *[]string {
+	databases := d.Get("databases").(*schema.Set).List()
+	results := make([]string, 0)
+
+	for _, v := range databases {
+		results = append(results, v.(string))
+	}
+
+	return &results
+}
+
+func flattenArmSqlFailoverGroupDatabases(input *[]string) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		results = append(results, v)
+	}
+
+	return results
+}
+
+func expandArmSqlFailoverGroupPartnerServers(d *schema.ResourceData) *[]sql.PartnerInfo {
+	servers := d.Get("partner_servers").([]interface{})
+	results := make([]sql.PartnerInfo, 0)
+
+	for _, v := range servers {
+		server := v.(map[string]interface{})
+		results = append(results, sql.PartnerInfo{
+			ID: utils.String(server["id"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenArmSqlFailoverGroupPartnerServers(input *[]sql.PartnerInfo) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, server := range *input {
+		result := map[string]interface{}{
+			"role": string(server.ReplicationRole),
+		}
+
+		if server.ID != nil {
+			result["id"] = *server.ID
+		}
+
+		if server.Location != nil {
+			result["location"] = azureRMNormalizeLocation(*server.Location)
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -5,10 +5,12 @@ import (
 	"log"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	sqlPreview "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-03-01-preview/sql"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -62,6 +64,62 @@ func resourceArmSqlServer() *schema.Resource {
 				Computed: true,
 			},
 
+			// the identity can't be removed once assigned, so this is Computed to retain it when the block is omitted
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.SystemAssigned),
+							}, false),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"extended_auditing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"storage_endpoint": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.URLIsHTTPS,
+						},
+
+						// the access key isn't returned by the API, so it's only available from the config
+						"storage_account_access_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"retention_in_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 3285),
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -89,6 +147,10 @@ func resourceArmSqlServerCreateUpdate(d *schema.ResourceData, meta interface{})
 		},
 	}
 
+	if _, ok := d.GetOk("identity"); ok {
+		parameters.Identity = expandAzureRmSqlServerIdentity(d)
+	}
+
 	if d.HasChange("administrator_login_password") {
 		adminPassword := d.Get("administrator_login_password").(string)
 		parameters.ServerProperties.AdministratorLoginPassword = utils.String(adminPassword)
@@ -115,6 +177,23 @@ func resourceArmSqlServerCreateUpdate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(*resp.ID)
 
+	// the auditing policy is a preview API, so it's only touched when it's been configured
+	if d.HasChange("extended_auditing_policy") {
+		auditingClient := meta.(*ArmClient).sqlExtendedServerBlobAuditingPoliciesClient
+		auditingParameters := sqlPreview.ExtendedServerBlobAuditingPolicy{
+			ExtendedServerBlobAuditingPolicyProperties: expandAzureRmSqlServerExtendedAuditingPolicy(d.Get("extended_auditing_policy").([]interface{})),
+		}
+
+		auditingFuture, err := auditingClient.CreateOrUpdate(ctx, resGroup, name, auditingParameters)
+		if err != nil {
+			return fmt.Errorf("Error setting Extended Auditing Policy for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err = auditingFuture.WaitForCompletionRef(ctx, auditingClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for Extended Auditing Policy for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
 	return resourceArmSqlServerRead(d, meta)
 }
 
@@ -153,6 +232,27 @@ func resourceArmSqlServerRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("fully_qualified_domain_name", serverProperties.FullyQualifiedDomainName)
 	}
 
+	if err := d.Set("identity", flattenAzureRmSqlServerIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	// the auditing policy is a preview API, so it's only read for Servers which have it configured
+	if v, ok := d.GetOk("extended_auditing_policy"); ok && len(v.([]interface{})) > 0 {
+		auditingClient := meta.(*ArmClient).sqlExtendedServerBlobAuditingPoliciesClient
+		auditingResp, err := auditingClient.Get(ctx, resGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(auditingResp.Response) && !utils.ResponseWasBadRequest(auditingResp.Response) {
+				return fmt.Errorf("Error retrieving Extended Auditing Policy for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+
+			log.Printf("[DEBUG] Extended Auditing Policy for SQL Server %q (Resource Group %q) was not found - treating as not configured", name, resGroup)
+		}
+
+		if err := d.Set("extended_auditing_policy", flattenAzureRmSqlServerExtendedAuditingPolicy(d, auditingResp.ExtendedServerBlobAuditingPolicyProperties)); err != nil {
+			return fmt.Errorf("Error setting `extended_auditing_policy`: %+v", err)
+		}
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -177,3 +277,68 @@ func resourceArmSqlServerDelete(d *schema.ResourceData, meta interface{}) error
 
 	return future.WaitForCompletionRef(ctx, client.Client)
 }
+
+func expandAzureRmSqlServerIdentity(d *schema.ResourceData) *sql.ResourceIdentity {
+	identities := d.Get("identity").([]interface{})
+	identity := identities[0].(map[string]interface{})
+	identityType := identity["type"].(string)
+	return &sql.ResourceIdentity{
+		Type: sql.IdentityType(identityType),
+	}
+}
+
+func flattenAzureRmSqlServerIdentity(identity *sql.ResourceIdentity) []interface{} {
+	if identity == nil {
+		return make([]interface{}, 0)
+	}
+
+	result := make(map[string]interface{})
+	result["type"] = string(identity.Type)
+	if identity.PrincipalID != nil {
+		result["principal_id"] = identity.PrincipalID.String()
+	}
+	if identity.TenantID != nil {
+		result["tenant_id"] = identity.TenantID.String()
+	}
+
+	return []interface{}{result}
+}
+
+func expandAzureRmSqlServerExtendedAuditingPolicy(input []interface{}) *sqlPreview.ExtendedServerBlobAuditingPolicyProperties {
+	if len(input) == 0 || input[0] == nil {
+		return &sqlPreview.ExtendedServerBlobAuditingPolicyProperties{
+			State: sqlPreview.BlobAuditingPolicyStateDisabled,
+		}
+	}
+
+	policy := input[0].(map[string]interface{})
+
+	return &sqlPreview.ExtendedServerBlobAuditingPolicyProperties{
+		State:                   sqlPreview.BlobAuditingPolicyStateEnabled,
+		StorageEndpoint:         utils.String(policy["storage_endpoint"].(string)),
+		StorageAccountAccessKey: utils.String(policy["storage_account_access_key"].(string)),
+		RetentionDays:           utils.Int32(int32(policy["retention_in_days"].(int))),
+	}
+}
+
+func flattenAzureRmSqlServerExtendedAuditingPolicy(d *schema.ResourceData, input *sqlPreview.ExtendedServerBlobAuditingPolicyProperties) []interface{} {
+	if input == nil || input.State != sqlPreview.BlobAuditingPolicyStateEnabled {
+		return make([]interface{}, 0)
+	}
+
+	result := make(map[string]interface{})
+
+	if input.StorageEndpoint != nil {
+		result["storage_endpoint"] = *input.StorageEndpoint
+	}
+	if input.RetentionDays != nil {
+		result["retention_in_days"] = int(*input.RetentionDays)
+	}
+
+	// the access key isn't returned by the API, so we pull it from the existing config
+	if v, ok := d.GetOk("extended_auditing_policy.0.storage_account_access_key"); ok {
+		result["storage_account_access_key"] = v.(string)
+	}
+
+	return []interface{}{result}
+}
@@ -0,0 +1,247 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSqlServerTransparentDataEncryption() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSqlServerTransparentDataEncryptionCreateUpdate,
+		Read:   resourceArmSqlServerTransparentDataEncryptionRead,
+		Update: resourceArmSqlServerTransparentDataEncryptionCreateUpdate,
+		Delete: resourceArmSqlServerTransparentDataEncryptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"key_vault_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateKeyVaultChildId,
+			},
+		},
+	}
+}
+
+func resourceArmSqlServerTransparentDataEncryptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlEncryptionProtectorsClient
+	keysClient := meta.(*ArmClient).sqlServerKeysClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+
+	log.Printf("[INFO] preparing arguments for Transparent Data Encryption for SQL Server %q (Resource Group %q)", serverName, resourceGroup)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		// the Encryption Protector always exists - so we only consider it to be managed when it's using a Key Vault Key
+		existing, err := client.Get(ctx, resourceGroup, serverName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Encryption Protector for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+			}
+		}
+
+		if props := existing.EncryptionProtectorProperties; props != nil && props.ServerKeyType == sql.AzureKeyVault && existing.ID != nil {
+			return tf.ImportAsExistsError("azurerm_sql_server_transparent_data_encryption", *existing.ID)
+		}
+	}
+
+	serverKeyName := "ServiceManaged"
+	serverKeyType := sql.ServiceManaged
+
+	if keyVaultKeyId := d.Get("key_vault_key_id").(string); keyVaultKeyId != "" {
+		name, err := sqlServerKeyNameFromKeyVaultKeyID(keyVaultKeyId)
+		if err != nil {
+			return err
+		}
+		serverKeyName = name
+		serverKeyType = sql.AzureKeyVault
+
+		serverKey := sql.ServerKey{
+			ServerKeyProperties: &sql.ServerKeyProperties{
+				ServerKeyType: sql.AzureKeyVault,
+				URI:           utils.String(keyVaultKeyId),
+			},
+		}
+
+		keyFuture, err := keysClient.CreateOrUpdate(ctx, resourceGroup, serverName, serverKeyName, serverKey)
+		if err != nil {
+			return fmt.Errorf("Error adding Key %q to SQL Server %q (Resource Group %q): %+v", serverKeyName, serverName, resourceGroup, err)
+		}
+
+		if err = keyFuture.WaitForCompletionRef(ctx, keysClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for Key %q to be added to SQL Server %q (Resource Group %q): %+v", serverKeyName, serverName, resourceGroup, err)
+		}
+	}
+
+	if err := sqlServerSetEncryptionProtector(d, meta, resourceGroup, serverName, serverKeyName, serverKeyType); err != nil {
+		return err
+	}
+
+	// the previous Key is no longer the Encryption Protector, so it can be removed from the SQL Server
+	if !d.IsNewResource() && d.HasChange("key_vault_key_id") {
+		if old, _ := d.GetChange("key_vault_key_id"); old.(string) != "" {
+			if err := sqlServerRemoveKeyVaultKey(meta, resourceGroup, serverName, old.(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serverName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Encryption Protector for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Encryption Protector for SQL Server %q (Resource Group %q)", serverName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSqlServerTransparentDataEncryptionRead(d, meta)
+}
+
+func resourceArmSqlServerTransparentDataEncryptionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlEncryptionProtectorsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Encryption Protector for SQL Server %q (Resource Group %q) was not found - removing from state", serverName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Encryption Protector for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+
+	keyVaultKeyId := ""
+	if props := resp.EncryptionProtectorProperties; props != nil && props.ServerKeyType == sql.AzureKeyVault && props.URI != nil {
+		keyVaultKeyId = *props.URI
+	}
+	d.Set("key_vault_key_id", keyVaultKeyId)
+
+	return nil
+}
+
+func resourceArmSqlServerTransparentDataEncryptionDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+
+	// the Encryption Protector can't be removed - so we revert to a Service Managed Key
+	if err := sqlServerSetEncryptionProtector(d, meta, resourceGroup, serverName, "ServiceManaged", sql.ServiceManaged); err != nil {
+		return err
+	}
+
+	if keyVaultKeyId := d.Get("key_vault_key_id").(string); keyVaultKeyId != "" {
+		return sqlServerRemoveKeyVaultKey(meta, resourceGroup, serverName, keyVaultKeyId)
+	}
+
+	return nil
+}
+
+func sqlServerSetEncryptionProtector(d *schema.ResourceData, meta interface{}, resourceGroup, serverName, serverKeyName string, serverKeyType sql.ServerKeyType) error {
+	client := meta.(*ArmClient).sqlEncryptionProtectorsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	parameters := sql.EncryptionProtector{
+		EncryptionProtectorProperties: &sql.EncryptionProtectorProperties{
+			ServerKeyName: utils.String(serverKeyName),
+			ServerKeyType: serverKeyType,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error setting Encryption Protector for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Encryption Protector for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// sqlServerRemoveKeyVaultKey removes the Server Key for a Key Vault Key from the SQL Server, which
+// can only be done once it's no longer being used as the Encryption Protector
+func sqlServerRemoveKeyVaultKey(meta interface{}, resourceGroup, serverName, keyVaultKeyId string) error {
+	client := meta.(*ArmClient).sqlServerKeysClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name, err := sqlServerKeyNameFromKeyVaultKeyID(keyVaultKeyId)
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error removing Key %q from SQL Server %q (Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error waiting for Key %q to be removed from SQL Server %q (Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// sqlServerKeyNameFromKeyVaultKeyID returns the name of the Server Key for a Key Vault Key,
+// which the API requires to be in the format `{vaultName}_{keyName}_{keyVersion}`
+func sqlServerKeyNameFromKeyVaultKeyID(input string) (string, error) {
+	id, err := azure.ParseKeyVaultChildID(input)
+	if err != nil {
+		return "", err
+	}
+
+	baseUrl, err := url.Parse(id.KeyVaultBaseUrl)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing Key Vault URI %q: %+v", id.KeyVaultBaseUrl, err)
+	}
+	vaultName := strings.Split(baseUrl.Host, ".")[0]
+
+	return fmt.Sprintf("%s_%s_%s", vaultName, id.Name, id.Version), nil
+}
@@ -0,0 +1,216 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMSqlServerTransparentDataEncryption_keyVault(t *testing.T) {
+	resourceName := "azurerm_sql_server_transparent_data_encryption.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerTransparentDataEncryptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlServerTransparentDataEncryption_keyVault(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerTransparentDataEncryptionType(resourceName, sql.AzureKeyVault),
+					resource.TestCheckResourceAttrSet(resourceName, "key_vault_key_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlServerTransparentDataEncryption_serviceManaged(t *testing.T) {
+	resourceName := "azurerm_sql_server_transparent_data_encryption.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerTransparentDataEncryptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlServerTransparentDataEncryption_keyVault(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerTransparentDataEncryptionType(resourceName, sql.AzureKeyVault),
+				),
+			},
+			{
+				Config: testAccAzureRMSqlServerTransparentDataEncryption_serviceManaged(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerTransparentDataEncryptionType(resourceName, sql.ServiceManaged),
+					resource.TestCheckResourceAttr(resourceName, "key_vault_key_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSqlServerTransparentDataEncryptionType(resourceName string, expected sql.ServerKeyType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).sqlEncryptionProtectorsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on sqlEncryptionProtectorsClient: %+v", err)
+		}
+
+		if props := resp.EncryptionProtectorProperties; props == nil || props.ServerKeyType != expected {
+			return fmt.Errorf("Bad: Encryption Protector for SQL Server %q (Resource Group %q) is not of type %q", serverName, resourceGroup, string(expected))
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSqlServerTransparentDataEncryptionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).sqlEncryptionProtectorsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_sql_server_transparent_data_encryption" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName)
+		if err != nil {
+			// the SQL Server may have been removed along with the Encryption Protector
+			return nil
+		}
+
+		if props := resp.EncryptionProtectorProperties; props != nil && props.ServerKeyType != sql.ServiceManaged {
+			return fmt.Errorf("Encryption Protector for SQL Server %q (Resource Group %q) still uses a Key Vault Key", serverName, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMSqlServerTransparentDataEncryption_keyVault(rInt int, rString string, location string) string {
+	template := testAccAzureRMSqlServerTransparentDataEncryption_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_server_transparent_data_encryption" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  key_vault_key_id    = "${azurerm_key_vault_key.test.id}"
+}
+`, template)
+}
+
+func testAccAzureRMSqlServerTransparentDataEncryption_serviceManaged(rInt int, rString string, location string) string {
+	template := testAccAzureRMSqlServerTransparentDataEncryption_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_server_transparent_data_encryption" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+}
+`, template)
+}
+
+func testAccAzureRMSqlServerTransparentDataEncryption_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "create",
+      "delete",
+      "get",
+    ]
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${azurerm_sql_server.test.identity.0.principal_id}"
+
+    key_permissions = [
+      "get",
+      "unwrapkey",
+      "wrapkey",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name      = "acctestkvkey%s"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+  key_type  = "RSA"
+  key_size  = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+}
+`, rInt, location, rInt, rString, rString)
+}
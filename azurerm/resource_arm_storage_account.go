@@ -1,12 +1,14 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
@@ -95,10 +97,12 @@ func resourceArmStorageAccount() *schema.Resource {
 				}, true),
 			},
 
+			// this is Computed so that the Key Source can be changed to `Microsoft.Keyvault` by the
+			// `azurerm_storage_account_customer_managed_key` resource, whilst still allowing it to be reverted
 			"account_encryption_source": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  string(storage.MicrosoftStorage),
+				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(storage.MicrosoftKeyvault),
 					string(storage.MicrosoftStorage),
@@ -180,6 +184,63 @@ func resourceArmStorageAccount() *schema.Resource {
 				},
 			},
 
+			"blob_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cors_rule": storageAccountCorsRuleSchema(),
+					},
+				},
+			},
+
+			"queue_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cors_rule": storageAccountCorsRuleSchema(),
+
+						"logging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"version": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"delete": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"read": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"write": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"retention_policy_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
+
+						"hour_metrics":   storageAccountMetricsSchema(),
+						"minute_metrics": storageAccountMetricsSchema(),
+					},
+				},
+			},
+
 			"primary_location": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -339,6 +400,9 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	replicationType := d.Get("account_replication_type").(string)
 	storageType := fmt.Sprintf("%s_%s", accountTier, replicationType)
 	storageAccountEncryptionSource := d.Get("account_encryption_source").(string)
+	if storageAccountEncryptionSource == "" {
+		storageAccountEncryptionSource = string(storage.MicrosoftStorage)
+	}
 
 	networkRules := expandStorageAccountNetworkRules(d)
 
@@ -415,6 +479,19 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] storage account %q ID: %q", storageAccountName, *account.ID)
 	d.SetId(*account.ID)
 
+	// the Blob and Queue Service Properties are configured via the Data Plane API once the Storage Account exists
+	if _, ok := d.GetOk("blob_properties"); ok {
+		if err := updateStorageAccountBlobProperties(ctx, meta, resourceGroupName, storageAccountName, d.Get("blob_properties").([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("queue_properties"); ok {
+		if err := updateStorageAccountQueueProperties(ctx, meta, resourceGroupName, storageAccountName, d.Get("queue_properties").([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmStorageAccountRead(d, meta)
 }
 
@@ -490,7 +567,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("tags")
 	}
 
-	if d.HasChange("enable_blob_encryption") || d.HasChange("enable_file_encryption") {
+	if d.HasChange("enable_blob_encryption") || d.HasChange("enable_file_encryption") || d.HasChange("account_encryption_source") {
 		encryptionSource := d.Get("account_encryption_source").(string)
 
 		opts := storage.AccountUpdateParameters{
@@ -502,6 +579,23 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 			},
 		}
 
+		// when a Customer Managed Key is in use (via `azurerm_storage_account_customer_managed_key`) the Key Vault
+		// Properties need to be sent along with the Encryption Services, otherwise they're removed - unless the
+		// Key Source is being reverted to `Microsoft.Storage`
+		existing, err := client.GetProperties(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Azure Storage Account %q: %+v", storageAccountName, err)
+		}
+		if props := existing.AccountProperties; props != nil && props.Encryption != nil {
+			if props.Encryption.Services != nil {
+				opts.Encryption.Services = props.Encryption.Services
+			}
+
+			if strings.EqualFold(encryptionSource, string(storage.MicrosoftKeyvault)) && props.Encryption.KeyVaultProperties != nil {
+				opts.Encryption.KeyVaultProperties = props.Encryption.KeyVaultProperties
+			}
+		}
+
 		if d.HasChange("enable_blob_encryption") {
 			enableEncryption := d.Get("enable_blob_encryption").(bool)
 			opts.Encryption.Services.Blob = &storage.EncryptionService{
@@ -519,10 +613,12 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 			d.SetPartial("enable_file_encryption")
 		}
 
-		_, err := client.Update(ctx, resourceGroupName, storageAccountName, opts)
+		_, err = client.Update(ctx, resourceGroupName, storageAccountName, opts)
 		if err != nil {
 			return fmt.Errorf("Error updating Azure Storage Account Encryption %q: %+v", storageAccountName, err)
 		}
+
+		d.SetPartial("account_encryption_source")
 	}
 
 	if d.HasChange("custom_domain") {
@@ -582,6 +678,22 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("network_rules")
 	}
 
+	if d.HasChange("blob_properties") {
+		if err := updateStorageAccountBlobProperties(ctx, meta, resourceGroupName, storageAccountName, d.Get("blob_properties").([]interface{})); err != nil {
+			return err
+		}
+
+		d.SetPartial("blob_properties")
+	}
+
+	if d.HasChange("queue_properties") {
+		if err := updateStorageAccountQueueProperties(ctx, meta, resourceGroupName, storageAccountName, d.Get("queue_properties").([]interface{})); err != nil {
+			return err
+		}
+
+		d.SetPartial("queue_properties")
+	}
+
 	d.Partial(false)
 	return resourceArmStorageAccountRead(d, meta)
 }
@@ -708,6 +820,40 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("primary_access_key", accessKeys[0].Value)
 	d.Set("secondary_access_key", accessKeys[1].Value)
 
+	// the Service Properties are only retrieved when they're being managed, since doing so requires access
+	// to the Data Plane API - which may be blocked by the `network_rules` of the Storage Account
+	if v := d.Get("blob_properties").([]interface{}); len(v) > 0 {
+		blobClient, _, err := meta.(*ArmClient).getBlobStorageClientForStorageAccount(ctx, resGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error building Blob Client for Storage Account %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		props, err := blobClient.GetServiceProperties()
+		if err != nil {
+			return fmt.Errorf("Error retrieving Blob Service Properties for Storage Account %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err := d.Set("blob_properties", flattenStorageAccountBlobProperties(props)); err != nil {
+			return fmt.Errorf("Error setting `blob_properties`: %+v", err)
+		}
+	}
+
+	if v := d.Get("queue_properties").([]interface{}); len(v) > 0 {
+		queueClient, _, err := meta.(*ArmClient).getQueueServiceClientForStorageAccount(ctx, resGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error building Queue Client for Storage Account %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		props, err := queueClient.GetServiceProperties()
+		if err != nil {
+			return fmt.Errorf("Error retrieving Queue Service Properties for Storage Account %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err := d.Set("queue_properties", flattenStorageAccountQueueProperties(props)); err != nil {
+			return fmt.Errorf("Error setting `queue_properties`: %+v", err)
+		}
+	}
+
 	identity := flattenAzureRmStorageAccountIdentity(resp.Identity)
 	if err := d.Set("identity", identity); err != nil {
 		return err
@@ -963,3 +1109,337 @@ func flattenAzureRmStorageAccountIdentity(identity *storage.Identity) []interfac
 
 	return []interface{}{result}
 }
+
+func storageAccountCorsRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 5,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed_origins": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 64,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.NoZeroValues,
+					},
+				},
+				"allowed_methods": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"DELETE",
+							"GET",
+							"HEAD",
+							"MERGE",
+							"POST",
+							"OPTIONS",
+							"PUT",
+						}, false),
+					},
+				},
+				"allowed_headers": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 64,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"exposed_headers": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 64,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"max_age_in_seconds": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 2000000000),
+				},
+			},
+		},
+	}
+}
+
+// storageAccountMetricsSchema is Computed since the Storage Service always returns the Metrics, even when they're disabled
+func storageAccountMetricsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"version": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+				"include_apis": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"retention_policy_days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 365),
+				},
+			},
+		},
+	}
+}
+
+func updateStorageAccountBlobProperties(ctx context.Context, meta interface{}, resourceGroup string, name string, input []interface{}) error {
+	blobClient, accountExists, err := meta.(*ArmClient).getBlobStorageClientForStorageAccount(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error building Blob Client for Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q (Resource Group %q) was not found", name, resourceGroup)
+	}
+
+	// retrieve the existing properties so that only the fields we manage are changed
+	props, err := blobClient.GetServiceProperties()
+	if err != nil {
+		return fmt.Errorf("Error retrieving Blob Service Properties for Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var corsRules []interface{}
+	if len(input) > 0 && input[0] != nil {
+		blobProperties := input[0].(map[string]interface{})
+		corsRules = blobProperties["cors_rule"].([]interface{})
+	}
+	props.Cors = expandStorageAccountCorsRules(corsRules)
+
+	if err := blobClient.SetServiceProperties(*props); err != nil {
+		return fmt.Errorf("Error updating Blob Service Properties for Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func updateStorageAccountQueueProperties(ctx context.Context, meta interface{}, resourceGroup string, name string, input []interface{}) error {
+	queueClient, accountExists, err := meta.(*ArmClient).getQueueServiceClientForStorageAccount(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error building Queue Client for Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q (Resource Group %q) was not found", name, resourceGroup)
+	}
+
+	// retrieve the existing properties so that only the fields we manage are changed
+	props, err := queueClient.GetServiceProperties()
+	if err != nil {
+		return fmt.Errorf("Error retrieving Queue Service Properties for Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	queueProperties := map[string]interface{}{
+		"cors_rule":      []interface{}{},
+		"logging":        []interface{}{},
+		"hour_metrics":   []interface{}{},
+		"minute_metrics": []interface{}{},
+	}
+	if len(input) > 0 && input[0] != nil {
+		queueProperties = input[0].(map[string]interface{})
+	}
+
+	props.Cors = expandStorageAccountCorsRules(queueProperties["cors_rule"].([]interface{}))
+	props.Logging = expandStorageAccountLogging(queueProperties["logging"].([]interface{}))
+	props.HourMetrics = expandStorageAccountMetrics(queueProperties["hour_metrics"].([]interface{}))
+	props.MinuteMetrics = expandStorageAccountMetrics(queueProperties["minute_metrics"].([]interface{}))
+
+	if err := queueClient.SetServiceProperties(*props); err != nil {
+		return fmt.Errorf("Error updating Queue Service Properties for Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandStorageAccountCorsRules(input []interface{}) *mainStorage.Cors {
+	rules := make([]mainStorage.CorsRule, 0)
+
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		rule := v.(map[string]interface{})
+		rules = append(rules, mainStorage.CorsRule{
+			AllowedOrigins:  strings.Join(*utils.ExpandStringArray(rule["allowed_origins"].([]interface{})), ","),
+			AllowedMethods:  strings.Join(*utils.ExpandStringArray(rule["allowed_methods"].([]interface{})), ","),
+			AllowedHeaders:  strings.Join(*utils.ExpandStringArray(rule["allowed_headers"].([]interface{})), ","),
+			ExposedHeaders:  strings.Join(*utils.ExpandStringArray(rule["exposed_headers"].([]interface{})), ","),
+			MaxAgeInSeconds: rule["max_age_in_seconds"].(int),
+		})
+	}
+
+	return &mainStorage.Cors{
+		CorsRule: rules,
+	}
+}
+
+func expandStorageAccountRetentionPolicy(days int) *mainStorage.RetentionPolicy {
+	if days == 0 {
+		return &mainStorage.RetentionPolicy{
+			Enabled: false,
+		}
+	}
+
+	return &mainStorage.RetentionPolicy{
+		Enabled: true,
+		Days:    utils.Int(days),
+	}
+}
+
+func expandStorageAccountLogging(input []interface{}) *mainStorage.Logging {
+	if len(input) == 0 || input[0] == nil {
+		return &mainStorage.Logging{
+			Version:         "1.0",
+			RetentionPolicy: expandStorageAccountRetentionPolicy(0),
+		}
+	}
+
+	logging := input[0].(map[string]interface{})
+	return &mainStorage.Logging{
+		Version:         logging["version"].(string),
+		Delete:          logging["delete"].(bool),
+		Read:            logging["read"].(bool),
+		Write:           logging["write"].(bool),
+		RetentionPolicy: expandStorageAccountRetentionPolicy(logging["retention_policy_days"].(int)),
+	}
+}
+
+func expandStorageAccountMetrics(input []interface{}) *mainStorage.Metrics {
+	if len(input) == 0 || input[0] == nil {
+		return &mainStorage.Metrics{
+			Version:         "1.0",
+			RetentionPolicy: expandStorageAccountRetentionPolicy(0),
+		}
+	}
+
+	metrics := input[0].(map[string]interface{})
+	output := &mainStorage.Metrics{
+		Version:         metrics["version"].(string),
+		Enabled:         metrics["enabled"].(bool),
+		RetentionPolicy: expandStorageAccountRetentionPolicy(metrics["retention_policy_days"].(int)),
+	}
+
+	// `IncludeAPIs` can only be specified when Metrics are enabled
+	if output.Enabled {
+		output.IncludeAPIs = utils.Bool(metrics["include_apis"].(bool))
+	}
+
+	return output
+}
+
+func flattenStorageAccountBlobProperties(input *mainStorage.ServiceProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cors_rule": flattenStorageAccountCorsRules(input.Cors),
+		},
+	}
+}
+
+func flattenStorageAccountQueueProperties(input *mainStorage.ServiceProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cors_rule":      flattenStorageAccountCorsRules(input.Cors),
+			"logging":        flattenStorageAccountLogging(input.Logging),
+			"hour_metrics":   flattenStorageAccountMetrics(input.HourMetrics),
+			"minute_metrics": flattenStorageAccountMetrics(input.MinuteMetrics),
+		},
+	}
+}
+
+func flattenStorageAccountCorsRules(input *mainStorage.Cors) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range input.CorsRule {
+		results = append(results, map[string]interface{}{
+			"allowed_origins":    flattenStorageAccountCorsRuleValues(rule.AllowedOrigins),
+			"allowed_methods":    flattenStorageAccountCorsRuleValues(rule.AllowedMethods),
+			"allowed_headers":    flattenStorageAccountCorsRuleValues(rule.AllowedHeaders),
+			"exposed_headers":    flattenStorageAccountCorsRuleValues(rule.ExposedHeaders),
+			"max_age_in_seconds": rule.MaxAgeInSeconds,
+		})
+	}
+
+	return results
+}
+
+func flattenStorageAccountCorsRuleValues(input string) []interface{} {
+	results := make([]interface{}, 0)
+	for _, v := range strings.Split(input, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			results = append(results, v)
+		}
+	}
+	return results
+}
+
+func flattenStorageAccountRetentionPolicyDays(input *mainStorage.RetentionPolicy) int {
+	if input == nil || !input.Enabled || input.Days == nil {
+		return 0
+	}
+
+	return *input.Days
+}
+
+func flattenStorageAccountLogging(input *mainStorage.Logging) []interface{} {
+	// when logging is disabled for all operations there's nothing to manage
+	if input == nil || (!input.Delete && !input.Read && !input.Write) {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"version":               input.Version,
+			"delete":                input.Delete,
+			"read":                  input.Read,
+			"write":                 input.Write,
+			"retention_policy_days": flattenStorageAccountRetentionPolicyDays(input.RetentionPolicy),
+		},
+	}
+}
+
+func flattenStorageAccountMetrics(input *mainStorage.Metrics) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	includeAPIs := false
+	if input.IncludeAPIs != nil {
+		includeAPIs = *input.IncludeAPIs
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"version":               input.Version,
+			"enabled":               input.Enabled,
+			"include_apis":          includeAPIs,
+			"retention_policy_days": flattenStorageAccountRetentionPolicyDays(input.RetentionPolicy),
+		},
+	}
+}
@@ -0,0 +1,301 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStorageAccountCustomerManagedKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageAccountCustomerManagedKeyCreateUpdate,
+		Read:   resourceArmStorageAccountCustomerManagedKeyRead,
+		Update: resourceArmStorageAccountCustomerManagedKeyCreateUpdate,
+		Delete: resourceArmStorageAccountCustomerManagedKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceArmStorageAccountCustomerManagedKeyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"key_vault_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"key_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"key_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func resourceArmStorageAccountCustomerManagedKeyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	storageAccountId := d.Get("storage_account_id").(string)
+	id, err := parseAzureResourceID(storageAccountId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	log.Printf("[INFO] preparing arguments for Customer Managed Key for Storage Account %q (Resource Group %q)", storageAccountName, resourceGroup)
+
+	account, err := client.GetProperties(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	if account.Identity == nil || account.Identity.PrincipalID == nil {
+		return fmt.Errorf("Storage Account %q (Resource Group %q) must have a System Assigned `identity` to use a Customer Managed Key", storageAccountName, resourceGroup)
+	}
+
+	keyVaultId := d.Get("key_vault_id").(string)
+	keyVaultUri, err := storageAccountCustomerManagedKeyVaultUri(ctx, meta, keyVaultId)
+	if err != nil {
+		return err
+	}
+
+	// the Encryption Services need to be sent along with the Key Source, otherwise they're disabled
+	services := &storage.EncryptionServices{
+		Blob: &storage.EncryptionService{
+			Enabled: utils.Bool(true),
+		},
+		File: &storage.EncryptionService{
+			Enabled: utils.Bool(true),
+		},
+	}
+	if props := account.AccountProperties; props != nil && props.Encryption != nil && props.Encryption.Services != nil {
+		services = props.Encryption.Services
+	}
+
+	parameters := storage.AccountUpdateParameters{
+		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+			Encryption: &storage.Encryption{
+				Services:  services,
+				KeySource: storage.MicrosoftKeyvault,
+				KeyVaultProperties: &storage.KeyVaultProperties{
+					KeyName:     utils.String(d.Get("key_name").(string)),
+					KeyVersion:  utils.String(d.Get("key_version").(string)),
+					KeyVaultURI: utils.String(keyVaultUri),
+				},
+			},
+		},
+	}
+
+	if _, err := client.Update(ctx, resourceGroup, storageAccountName, parameters); err != nil {
+		return fmt.Errorf("Error updating Customer Managed Key for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	// the Customer Managed Key is a property of the Storage Account - so we reuse its ID
+	d.SetId(storageAccountId)
+
+	return resourceArmStorageAccountCustomerManagedKeyRead(d, meta)
+}
+
+func resourceArmStorageAccountCustomerManagedKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageServiceClient
+	vaultsClient := meta.(*ArmClient).keyVaultClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	resp, err := client.GetProperties(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Storage Account %q was not found in Resource Group %q - removing Customer Managed Key from state!", storageAccountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	var keyVaultProperties *storage.KeyVaultProperties
+	if props := resp.AccountProperties; props != nil && props.Encryption != nil {
+		if strings.EqualFold(string(props.Encryption.KeySource), string(storage.MicrosoftKeyvault)) {
+			keyVaultProperties = props.Encryption.KeyVaultProperties
+		}
+	}
+
+	if keyVaultProperties == nil {
+		log.Printf("[DEBUG] Storage Account %q (Resource Group %q) isn't using a Customer Managed Key - removing from state!", storageAccountName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_account_id", d.Id())
+	d.Set("key_name", keyVaultProperties.KeyName)
+	d.Set("key_version", keyVaultProperties.KeyVersion)
+
+	// the API only returns the Key Vault URI - since finding the Key Vault for a URI means listing every Key Vault
+	// in the Subscription, this is only done when importing or when the Key Vault in the URI has changed
+	keyVaultUri := ""
+	if keyVaultProperties.KeyVaultURI != nil {
+		keyVaultUri = *keyVaultProperties.KeyVaultURI
+	}
+
+	if keyVaultUri != "" && !storageAccountCustomerManagedKeyVaultUriMatchesId(keyVaultUri, d.Get("key_vault_id").(string)) {
+		keyVaultId, err := azure.GetKeyVaultIDFromBaseUrl(ctx, vaultsClient, keyVaultUri)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the Key Vault ID for %q: %+v", keyVaultUri, err)
+		}
+
+		if keyVaultId == nil {
+			return fmt.Errorf("Unable to find a Key Vault with the URI %q used by Storage Account %q (Resource Group %q)", keyVaultUri, storageAccountName, resourceGroup)
+		}
+
+		d.Set("key_vault_id", keyVaultId)
+	}
+
+	return nil
+}
+
+func resourceArmStorageAccountCustomerManagedKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	account, err := client.GetProperties(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(account.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	services := &storage.EncryptionServices{
+		Blob: &storage.EncryptionService{
+			Enabled: utils.Bool(true),
+		},
+		File: &storage.EncryptionService{
+			Enabled: utils.Bool(true),
+		},
+	}
+	if props := account.AccountProperties; props != nil && props.Encryption != nil && props.Encryption.Services != nil {
+		services = props.Encryption.Services
+	}
+
+	// the Customer Managed Key can't be removed, instead we revert to using Microsoft Managed Keys
+	parameters := storage.AccountUpdateParameters{
+		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+			Encryption: &storage.Encryption{
+				Services:  services,
+				KeySource: storage.MicrosoftStorage,
+			},
+		},
+	}
+
+	if _, err := client.Update(ctx, resourceGroup, storageAccountName, parameters); err != nil {
+		return fmt.Errorf("Error removing Customer Managed Key from Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// resourceArmStorageAccountCustomerManagedKeyCustomizeDiff ensures that the Key Vault has both Soft Delete and Purge Protection
+// enabled at plan time, since otherwise the Key could be permanently deleted - and the data in the Storage Account lost
+func resourceArmStorageAccountCustomerManagedKeyCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("key_vault_id") {
+		return nil
+	}
+
+	keyVaultId := diff.Get("key_vault_id").(string)
+	if keyVaultId == "" {
+		return nil
+	}
+
+	_, err := storageAccountCustomerManagedKeyVaultUri(meta.(*ArmClient).StopContext, meta, keyVaultId)
+	return err
+}
+
+// storageAccountCustomerManagedKeyVaultUri returns the URI of the specified Key Vault, once it's confirmed
+// that Soft Delete and Purge Protection are enabled - both of which are required to use a Customer Managed Key
+func storageAccountCustomerManagedKeyVaultUri(ctx context.Context, meta interface{}, keyVaultId string) (string, error) {
+	client := meta.(*ArmClient).keyVaultClient
+
+	id, err := parseAzureResourceID(keyVaultId)
+	if err != nil {
+		return "", err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["vaults"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	props := resp.Properties
+	if props == nil || props.VaultURI == nil {
+		return "", fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): `properties.VaultURI` was nil", name, resourceGroup)
+	}
+
+	if props.EnableSoftDelete == nil || !*props.EnableSoftDelete {
+		return "", fmt.Errorf("Key Vault %q (Resource Group %q) must have Soft Delete enabled to be used for a Customer Managed Key", name, resourceGroup)
+	}
+
+	if props.EnablePurgeProtection == nil || !*props.EnablePurgeProtection {
+		return "", fmt.Errorf("Key Vault %q (Resource Group %q) must have Purge Protection enabled to be used for a Customer Managed Key", name, resourceGroup)
+	}
+
+	return *props.VaultURI, nil
+}
+
+// storageAccountCustomerManagedKeyVaultUriMatchesId returns whether the Key Vault URI (in the format
+// `https://{name}.vault.azure.net/`) refers to the Key Vault with the specified Resource ID
+func storageAccountCustomerManagedKeyVaultUriMatchesId(keyVaultUri string, keyVaultId string) bool {
+	if keyVaultId == "" {
+		return false
+	}
+
+	id, err := parseAzureResourceID(keyVaultId)
+	if err != nil {
+		return false
+	}
+
+	uri, err := url.Parse(keyVaultUri)
+	if err != nil {
+		return false
+	}
+
+	return strings.HasPrefix(strings.ToLower(uri.Host), strings.ToLower(id.Path["vaults"])+".")
+}
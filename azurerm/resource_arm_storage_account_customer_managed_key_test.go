@@ -0,0 +1,169 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestStorageAccountCustomerManagedKeyVaultUriMatchesId(t *testing.T) {
+	keyVaultId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1"
+	testCases := []struct {
+		uri      string
+		id       string
+		expected bool
+	}{
+		{"https://vault1.vault.azure.net/", keyVaultId, true},
+		{"https://VAULT1.vault.azure.net", keyVaultId, true},
+		{"https://vault1.vault.usgovcloudapi.net/", keyVaultId, true},
+		{"https://vault2.vault.azure.net/", keyVaultId, false},
+		{"https://vault10.vault.azure.net/", keyVaultId, false},
+		{"https://vault1.vault.azure.net/", "", false},
+	}
+
+	for _, test := range testCases {
+		if actual := storageAccountCustomerManagedKeyVaultUriMatchesId(test.uri, test.id); actual != test.expected {
+			t.Fatalf("Expected %q matching %q to be %t but got %t", test.uri, test.id, test.expected, actual)
+		}
+	}
+}
+
+func TestAccAzureRMStorageAccountCustomerManagedKey_basic(t *testing.T) {
+	resourceName := "azurerm_storage_account_customer_managed_key.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageAccountCustomerManagedKey_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists("azurerm_storage_account.test"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_vault_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_version"),
+					resource.TestCheckResourceAttr(resourceName, "key_name", fmt.Sprintf("acctestkvkey%s", rs)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageAccountCustomerManagedKey_softDeleteDisabled(t *testing.T) {
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMStorageAccountCustomerManagedKey_softDeleteDisabled(ri, rs, location),
+				ExpectError: regexp.MustCompile("must have Soft Delete enabled"),
+			},
+		},
+	})
+}
+
+func testAccAzureRMStorageAccountCustomerManagedKey_basic(rInt int, rString string, location string) string {
+	return testAccAzureRMStorageAccountCustomerManagedKey_template(rInt, rString, location, true)
+}
+
+func testAccAzureRMStorageAccountCustomerManagedKey_softDeleteDisabled(rInt int, rString string, location string) string {
+	return testAccAzureRMStorageAccountCustomerManagedKey_template(rInt, rString, location, false)
+}
+
+func testAccAzureRMStorageAccountCustomerManagedKey_template(rInt int, rString string, location string, softDeleteEnabled bool) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  soft_delete_enabled      = %t
+  purge_protection_enabled = %t
+
+  sku {
+    name = "standard"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "create",
+      "delete",
+      "get",
+    ]
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${azurerm_storage_account.test.identity.0.principal_id}"
+
+    key_permissions = [
+      "get",
+      "unwrapkey",
+      "wrapkey",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name      = "acctestkvkey%s"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+  key_type  = "RSA"
+  key_size  = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+}
+
+resource "azurerm_storage_account_customer_managed_key" "test" {
+  storage_account_id = "${azurerm_storage_account.test.id}"
+  key_vault_id       = "${azurerm_key_vault.test.id}"
+  key_name           = "${azurerm_key_vault_key.test.name}"
+  key_version        = "${azurerm_key_vault_key.test.version}"
+}
+`, rInt, location, rString, rString, softDeleteEnabled, softDeleteEnabled, rString)
+}
@@ -530,6 +530,93 @@ func TestAccAzureRMStorageAccount_networkRulesDeleted(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageAccount_blobProperties(t *testing.T) {
+	resourceName := "azurerm_storage_account.testsa"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageAccount_blobProperties(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.cors_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.cors_rule.0.max_age_in_seconds", "3600"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageAccount_blobPropertiesUpdated(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.cors_rule.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the Service Properties are only read from the Data Plane when they're present in the state,
+				// which isn't the case during an import - so these are populated on the next apply instead
+				ImportStateVerifyIgnore: []string{"blob_properties"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageAccount_queueProperties(t *testing.T) {
+	resourceName := "azurerm_storage_account.testsa"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageAccount_queueProperties(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.cors_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.logging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.logging.0.retention_policy_days", "10"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.hour_metrics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.hour_metrics.0.include_apis", "true"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.minute_metrics.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageAccount_queuePropertiesUpdated(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.hour_metrics.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.minute_metrics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.minute_metrics.0.enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the Service Properties are only read from the Data Plane when they're present in the state,
+				// which isn't the case during an import - so these are populated on the next apply instead
+				ImportStateVerifyIgnore: []string{"queue_properties"},
+			},
+		},
+	})
+}
+
 func testCheckAzureRMStorageAccountExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -1032,3 +1119,161 @@ resource "azurerm_storage_account" "testsa" {
 }
 `, rInt, location, rInt, rInt, rString)
 }
+
+func testAccAzureRMStorageAccount_blobProperties(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "testrg" {
+  name     = "acctestAzureRMSA-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "testsa" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = "${azurerm_resource_group.testrg.name}"
+  location                 = "${azurerm_resource_group.testrg.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    cors_rule {
+      allowed_origins    = ["http://www.example.com"]
+      allowed_methods    = ["GET", "PUT"]
+      allowed_headers    = ["x-ms-meta-*"]
+      exposed_headers    = ["x-ms-meta-*"]
+      max_age_in_seconds = 3600
+    }
+  }
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageAccount_blobPropertiesUpdated(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "testrg" {
+  name     = "acctestAzureRMSA-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "testsa" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = "${azurerm_resource_group.testrg.name}"
+  location                 = "${azurerm_resource_group.testrg.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    cors_rule {
+      allowed_origins    = ["http://www.example.com"]
+      allowed_methods    = ["GET", "PUT"]
+      allowed_headers    = ["x-ms-meta-*"]
+      exposed_headers    = ["x-ms-meta-*"]
+      max_age_in_seconds = 3600
+    }
+
+    cors_rule {
+      allowed_origins    = ["http://www.example.org", "http://www.example.net"]
+      allowed_methods    = ["GET", "HEAD", "OPTIONS"]
+      allowed_headers    = ["*"]
+      exposed_headers    = ["*"]
+      max_age_in_seconds = 600
+    }
+  }
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageAccount_queueProperties(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "testrg" {
+  name     = "acctestAzureRMSA-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "testsa" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = "${azurerm_resource_group.testrg.name}"
+  location                 = "${azurerm_resource_group.testrg.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  queue_properties {
+    cors_rule {
+      allowed_origins    = ["http://www.example.com"]
+      allowed_methods    = ["GET", "PUT"]
+      allowed_headers    = ["x-tempo-*"]
+      exposed_headers    = ["x-tempo-*"]
+      max_age_in_seconds = 500
+    }
+
+    logging {
+      version               = "1.0"
+      delete                = true
+      read                  = true
+      write                 = true
+      retention_policy_days = 10
+    }
+
+    hour_metrics {
+      version               = "1.0"
+      enabled               = true
+      include_apis          = true
+      retention_policy_days = 10
+    }
+
+    minute_metrics {
+      version               = "1.0"
+      enabled               = true
+      include_apis          = false
+      retention_policy_days = 10
+    }
+  }
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageAccount_queuePropertiesUpdated(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "testrg" {
+  name     = "acctestAzureRMSA-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "testsa" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = "${azurerm_resource_group.testrg.name}"
+  location                 = "${azurerm_resource_group.testrg.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  queue_properties {
+    cors_rule {
+      allowed_origins    = ["http://www.example.com"]
+      allowed_methods    = ["GET", "PUT"]
+      allowed_headers    = ["x-tempo-*"]
+      exposed_headers    = ["x-tempo-*"]
+      max_age_in_seconds = 500
+    }
+
+    logging {
+      version               = "1.0"
+      delete                = true
+      read                  = true
+      write                 = true
+      retention_policy_days = 10
+    }
+
+    hour_metrics {
+      version               = "1.0"
+      enabled               = true
+      include_apis          = true
+      retention_policy_days = 10
+    }
+
+    minute_metrics {
+      version = "1.0"
+      enabled = false
+    }
+  }
+}
+`, rInt, location, rString)
+}
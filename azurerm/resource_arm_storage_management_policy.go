@@ -0,0 +1,519 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2018-07-01/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const storageManagementPolicyVersion = "0.5"
+
+func resourceArmStorageManagementPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageManagementPolicyCreateUpdate,
+		Read:   resourceArmStorageManagementPolicyRead,
+		Update: resourceArmStorageManagementPolicyCreateUpdate,
+		Delete: resourceArmStorageManagementPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceArmStorageManagementPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateStorageManagementPolicyRuleName,
+						},
+
+						"filters": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix_match": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 10,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+										Set: schema.HashString,
+									},
+
+									"blob_types": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{"blockBlob"}, false),
+										},
+										Set: schema.HashString,
+									},
+								},
+							},
+						},
+
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"base_blob": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tier_to_cool_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(1, 99999),
+												},
+												"tier_to_archive_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(1, 99999),
+												},
+												"delete_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(1, 99999),
+												},
+											},
+										},
+									},
+
+									"snapshot": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delete_after_days_since_creation_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(1, 99999),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmStorageManagementPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageManagementPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	storageAccountId := d.Get("storage_account_id").(string)
+	id, err := parseAzureResourceID(storageAccountId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	log.Printf("[INFO] preparing arguments for Management Policy for Storage Account %q (Resource Group %q)", storageAccountName, resourceGroup)
+
+	// the Management Policy is a singleton on the Storage Account, so we need to check for an existing one
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, storageAccountName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Management Policy for Storage Account %q (Resource Group %q): %s", storageAccountName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_storage_management_policy", *existing.ID)
+		}
+	}
+
+	rules := d.Get("rule").([]interface{})
+	parameters := storage.ManagementPoliciesRulesSetParameter{
+		ManagementPoliciesRules: &storage.ManagementPoliciesRules{
+			Policy: expandStorageManagementPolicy(rules),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, storageAccountName, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Management Policy for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Management Policy for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Management Policy for Storage Account %q (Resource Group %q)", storageAccountName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmStorageManagementPolicyRead(d, meta)
+}
+
+func resourceArmStorageManagementPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageManagementPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	resp, err := client.Get(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Management Policy for Storage Account %q was not found in Resource Group %q - removing from state!", storageAccountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Management Policy for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	storageAccountId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s", id.SubscriptionID, resourceGroup, storageAccountName)
+	d.Set("storage_account_id", storageAccountId)
+
+	if props := resp.AccountManagementPoliciesRulesProperty; props != nil {
+		rules, err := flattenStorageManagementPolicy(props.Policy)
+		if err != nil {
+			return fmt.Errorf("Error flattening Management Policy for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+		}
+
+		if err := d.Set("rule", rules); err != nil {
+			return fmt.Errorf("Error setting `rule`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStorageManagementPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageManagementPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	resp, err := client.Delete(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Management Policy for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceArmStorageManagementPolicyCustomizeDiff validates the rules which make up the Management Policy, since
+// the API only validates the Policy (which is submitted as a JSON document) once it's been sent to Azure
+func resourceArmStorageManagementPolicyCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	names := make(map[string]struct{})
+
+	for i, raw := range diff.Get("rule").([]interface{}) {
+		rule := raw.(map[string]interface{})
+
+		name := rule["name"].(string)
+		if name != "" {
+			if _, exists := names[strings.ToLower(name)]; exists {
+				return fmt.Errorf("`rule.%d.name`: a rule named %q has already been defined - rule names must be unique", i, name)
+			}
+			names[strings.ToLower(name)] = struct{}{}
+		}
+
+		actions := rule["actions"].([]interface{})
+		if len(actions) == 0 || actions[0] == nil {
+			continue
+		}
+
+		definedActions := 0
+		action := actions[0].(map[string]interface{})
+		if baseBlobs := action["base_blob"].([]interface{}); len(baseBlobs) > 0 && baseBlobs[0] != nil {
+			baseBlob := baseBlobs[0].(map[string]interface{})
+			for _, key := range []string{
+				"tier_to_cool_after_days_since_modification_greater_than",
+				"tier_to_archive_after_days_since_modification_greater_than",
+				"delete_after_days_since_modification_greater_than",
+			} {
+				if baseBlob[key].(int) > 0 {
+					definedActions++
+				}
+			}
+		}
+
+		if snapshots := action["snapshot"].([]interface{}); len(snapshots) > 0 && snapshots[0] != nil {
+			snapshot := snapshots[0].(map[string]interface{})
+			if snapshot["delete_after_days_since_creation_greater_than"].(int) > 0 {
+				definedActions++
+			}
+		}
+
+		if definedActions == 0 {
+			return fmt.Errorf("`rule.%d.actions`: at least one action must be specified for the rule %q", i, name)
+		}
+	}
+
+	return nil
+}
+
+func validateStorageManagementPolicyRuleName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if len(v) == 0 || len(v) > 256 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 256 characters in length", k))
+	}
+
+	if _, errs := validate.RegExHelper(v, k, `^[a-zA-Z0-9]*$`); errs != nil {
+		errors = append(errors, fmt.Errorf("%q can only contain alphanumeric characters", k))
+	}
+
+	return warnings, errors
+}
+
+func expandStorageManagementPolicy(input []interface{}) map[string]interface{} {
+	rules := make([]interface{}, 0)
+
+	for _, raw := range input {
+		rule := raw.(map[string]interface{})
+
+		definition := map[string]interface{}{
+			"filters": expandStorageManagementPolicyFilters(rule["filters"].([]interface{})),
+			"actions": expandStorageManagementPolicyActions(rule["actions"].([]interface{})),
+		}
+
+		rules = append(rules, map[string]interface{}{
+			"name":       rule["name"].(string),
+			"type":       "Lifecycle",
+			"definition": definition,
+		})
+	}
+
+	return map[string]interface{}{
+		"version": storageManagementPolicyVersion,
+		"rules":   rules,
+	}
+}
+
+func expandStorageManagementPolicyFilters(input []interface{}) map[string]interface{} {
+	filters := make(map[string]interface{})
+	if len(input) == 0 || input[0] == nil {
+		return filters
+	}
+
+	v := input[0].(map[string]interface{})
+
+	if prefixes := v["prefix_match"].(*schema.Set).List(); len(prefixes) > 0 {
+		filters["prefixMatch"] = prefixes
+	}
+
+	if blobTypes := v["blob_types"].(*schema.Set).List(); len(blobTypes) > 0 {
+		filters["blobTypes"] = blobTypes
+	}
+
+	return filters
+}
+
+func expandStorageManagementPolicyActions(input []interface{}) map[string]interface{} {
+	actions := make(map[string]interface{})
+	if len(input) == 0 || input[0] == nil {
+		return actions
+	}
+
+	v := input[0].(map[string]interface{})
+
+	if baseBlobs := v["base_blob"].([]interface{}); len(baseBlobs) > 0 && baseBlobs[0] != nil {
+		baseBlob := baseBlobs[0].(map[string]interface{})
+		baseBlobActions := make(map[string]interface{})
+
+		if days := baseBlob["tier_to_cool_after_days_since_modification_greater_than"].(int); days > 0 {
+			baseBlobActions["tierToCool"] = map[string]interface{}{
+				"daysAfterModificationGreaterThan": days,
+			}
+		}
+
+		if days := baseBlob["tier_to_archive_after_days_since_modification_greater_than"].(int); days > 0 {
+			baseBlobActions["tierToArchive"] = map[string]interface{}{
+				"daysAfterModificationGreaterThan": days,
+			}
+		}
+
+		if days := baseBlob["delete_after_days_since_modification_greater_than"].(int); days > 0 {
+			baseBlobActions["delete"] = map[string]interface{}{
+				"daysAfterModificationGreaterThan": days,
+			}
+		}
+
+		actions["baseBlob"] = baseBlobActions
+	}
+
+	if snapshots := v["snapshot"].([]interface{}); len(snapshots) > 0 && snapshots[0] != nil {
+		snapshot := snapshots[0].(map[string]interface{})
+		snapshotActions := make(map[string]interface{})
+
+		if days := snapshot["delete_after_days_since_creation_greater_than"].(int); days > 0 {
+			snapshotActions["delete"] = map[string]interface{}{
+				"daysAfterCreationGreaterThan": days,
+			}
+		}
+
+		actions["snapshot"] = snapshotActions
+	}
+
+	return actions
+}
+
+func flattenStorageManagementPolicy(input interface{}) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	policy, ok := input.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected the Policy to be a JSON object but got %T", input)
+	}
+
+	rules, ok := policy["rules"].([]interface{})
+	if !ok {
+		return results, nil
+	}
+
+	for _, raw := range rules {
+		rule, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		output := map[string]interface{}{
+			"filters": make([]interface{}, 0),
+			"actions": make([]interface{}, 0),
+		}
+
+		if name, ok := rule["name"].(string); ok {
+			output["name"] = name
+		}
+
+		if definition, ok := rule["definition"].(map[string]interface{}); ok {
+			if filters, ok := definition["filters"].(map[string]interface{}); ok {
+				output["filters"] = flattenStorageManagementPolicyFilters(filters)
+			}
+
+			if actions, ok := definition["actions"].(map[string]interface{}); ok {
+				output["actions"] = flattenStorageManagementPolicyActions(actions)
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results, nil
+}
+
+func flattenStorageManagementPolicyFilters(input map[string]interface{}) []interface{} {
+	prefixMatch := make([]interface{}, 0)
+	if v, ok := input["prefixMatch"].([]interface{}); ok {
+		prefixMatch = v
+	}
+
+	blobTypes := make([]interface{}, 0)
+	if v, ok := input["blobTypes"].([]interface{}); ok {
+		blobTypes = v
+	}
+
+	if len(prefixMatch) == 0 && len(blobTypes) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"prefix_match": schema.NewSet(schema.HashString, prefixMatch),
+			"blob_types":   schema.NewSet(schema.HashString, blobTypes),
+		},
+	}
+}
+
+func flattenStorageManagementPolicyActions(input map[string]interface{}) []interface{} {
+	output := make(map[string]interface{})
+
+	if baseBlob, ok := input["baseBlob"].(map[string]interface{}); ok {
+		output["base_blob"] = []interface{}{
+			map[string]interface{}{
+				"tier_to_cool_after_days_since_modification_greater_than":    flattenStorageManagementPolicyDays(baseBlob, "tierToCool", "daysAfterModificationGreaterThan"),
+				"tier_to_archive_after_days_since_modification_greater_than": flattenStorageManagementPolicyDays(baseBlob, "tierToArchive", "daysAfterModificationGreaterThan"),
+				"delete_after_days_since_modification_greater_than":          flattenStorageManagementPolicyDays(baseBlob, "delete", "daysAfterModificationGreaterThan"),
+			},
+		}
+	}
+
+	if snapshot, ok := input["snapshot"].(map[string]interface{}); ok {
+		output["snapshot"] = []interface{}{
+			map[string]interface{}{
+				"delete_after_days_since_creation_greater_than": flattenStorageManagementPolicyDays(snapshot, "delete", "daysAfterCreationGreaterThan"),
+			},
+		}
+	}
+
+	return []interface{}{output}
+}
+
+// flattenStorageManagementPolicyDays returns the number of days configured for the specified action,
+// or 0 (which is the zero value for the field in the schema) when the action isn't configured
+func flattenStorageManagementPolicyDays(input map[string]interface{}, action string, condition string) int {
+	v, ok := input[action].(map[string]interface{})
+	if !ok {
+		return 0
+	}
+
+	// numbers within the JSON document are unmarshalled as float64's
+	days, ok := v[condition].(float64)
+	if !ok {
+		return 0
+	}
+
+	return int(days)
+}
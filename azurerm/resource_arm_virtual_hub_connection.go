@@ -0,0 +1,254 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVirtualHubConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualHubConnectionCreateUpdate,
+		Read:   resourceArmVirtualHubConnectionRead,
+		Update: resourceArmVirtualHubConnectionCreateUpdate,
+		Delete: resourceArmVirtualHubConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"remote_virtual_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"allow_hub_to_remote_vnet_transit": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"allow_remote_vnet_to_use_hub_vnet_gateways": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enable_internet_security": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualHubConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Virtual Hub Connection creation.")
+
+	name := d.Get("name").(string)
+	hubID, err := parseAzureResourceID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := hubID.ResourceGroup
+	hubName := hubID.Path["virtualHubs"]
+
+	// Virtual Hub Connections can only be managed as a part of the Virtual Hub
+	azureRMLockByName(hubName, virtualHubResourceName)
+	defer azureRMUnlockByName(hubName, virtualHubResourceName)
+
+	hub, err := client.Get(ctx, resourceGroup, hubName)
+	if err != nil {
+		if utils.ResponseWasNotFound(hub.Response) {
+			return fmt.Errorf("Virtual Hub %q (Resource Group %q) was not found", hubName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", hubName, resourceGroup, err)
+	}
+
+	if hub.VirtualHubProperties == nil {
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): `properties` was nil", hubName, resourceGroup)
+	}
+
+	connections := make([]network.HubVirtualNetworkConnection, 0)
+	if existing := hub.VirtualHubProperties.VirtualNetworkConnections; existing != nil {
+		for _, connection := range *existing {
+			if connection.Name != nil && *connection.Name == name {
+				if requireResourcesToBeImported && d.IsNewResource() && connection.ID != nil {
+					return tf.ImportAsExistsError("azurerm_virtual_hub_connection", *connection.ID)
+				}
+
+				// this connection is being updated, so we'll replace it below
+				continue
+			}
+
+			connections = append(connections, connection)
+		}
+	}
+
+	connections = append(connections, network.HubVirtualNetworkConnection{
+		Name: utils.String(name),
+		HubVirtualNetworkConnectionProperties: &network.HubVirtualNetworkConnectionProperties{
+			RemoteVirtualNetwork: &network.SubResource{
+				ID: utils.String(d.Get("remote_virtual_network_id").(string)),
+			},
+			AllowHubToRemoteVnetTransit:         utils.Bool(d.Get("allow_hub_to_remote_vnet_transit").(bool)),
+			AllowRemoteVnetToUseHubVnetGateways: utils.Bool(d.Get("allow_remote_vnet_to_use_hub_vnet_gateways").(bool)),
+			EnableInternetSecurity:              utils.Bool(d.Get("enable_internet_security").(bool)),
+		},
+	})
+	hub.VirtualHubProperties.VirtualNetworkConnections = &connections
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, hubName, hub)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Connection %q for Virtual Hub %q (Resource Group %q): %+v", name, hubName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Connection %q for Virtual Hub %q (Resource Group %q): %+v", name, hubName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, hubName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", hubName, resourceGroup, err)
+	}
+
+	connection, _ := findArmVirtualHubConnectionByName(read, name)
+	if connection == nil || connection.ID == nil {
+		return fmt.Errorf("Cannot read Connection %q for Virtual Hub %q (Resource Group %q) ID", name, hubName, resourceGroup)
+	}
+
+	d.SetId(*connection.ID)
+
+	return resourceArmVirtualHubConnectionRead(d, meta)
+}
+
+func resourceArmVirtualHubConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	hubName := id.Path["virtualHubs"]
+	name := id.Path["hubVirtualNetworkConnections"]
+
+	hub, err := client.Get(ctx, resourceGroup, hubName)
+	if err != nil {
+		if utils.ResponseWasNotFound(hub.Response) {
+			log.Printf("[DEBUG] Virtual Hub %q was not found in Resource Group %q - removing Connection %q from state!", hubName, resourceGroup, name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Virtual Hub %q (Resource Group %q): %+v", hubName, resourceGroup, err)
+	}
+
+	connection, _ := findArmVirtualHubConnectionByName(hub, name)
+	if connection == nil {
+		log.Printf("[DEBUG] Connection %q was not found in Virtual Hub %q (Resource Group %q) - removing from state!", name, hubName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", connection.Name)
+	d.Set("virtual_hub_id", hub.ID)
+
+	if props := connection.HubVirtualNetworkConnectionProperties; props != nil {
+		if vnet := props.RemoteVirtualNetwork; vnet != nil {
+			d.Set("remote_virtual_network_id", vnet.ID)
+		}
+		d.Set("allow_hub_to_remote_vnet_transit", props.AllowHubToRemoteVnetTransit)
+		d.Set("allow_remote_vnet_to_use_hub_vnet_gateways", props.AllowRemoteVnetToUseHubVnetGateways)
+		d.Set("enable_internet_security", props.EnableInternetSecurity)
+	}
+
+	return nil
+}
+
+func resourceArmVirtualHubConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	hubName := id.Path["virtualHubs"]
+	name := id.Path["hubVirtualNetworkConnections"]
+
+	azureRMLockByName(hubName, virtualHubResourceName)
+	defer azureRMUnlockByName(hubName, virtualHubResourceName)
+
+	hub, err := client.Get(ctx, resourceGroup, hubName)
+	if err != nil {
+		if utils.ResponseWasNotFound(hub.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", hubName, resourceGroup, err)
+	}
+
+	_, index := findArmVirtualHubConnectionByName(hub, name)
+	if index == -1 {
+		return nil
+	}
+
+	existing := *hub.VirtualHubProperties.VirtualNetworkConnections
+	connections := append(existing[:index], existing[index+1:]...)
+	hub.VirtualHubProperties.VirtualNetworkConnections = &connections
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, hubName, hub)
+	if err != nil {
+		return fmt.Errorf("Error deleting Connection %q from Virtual Hub %q (Resource Group %q): %+v", name, hubName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the deletion of Connection %q from Virtual Hub %q (Resource Group %q): %+v", name, hubName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func findArmVirtualHubConnectionByName(hub network.VirtualHub, name string) (*network.HubVirtualNetworkConnection, int) {
+	if hub.VirtualHubProperties == nil || hub.VirtualHubProperties.VirtualNetworkConnections == nil {
+		return nil, -1
+	}
+
+	for i, connection := range *hub.VirtualHubProperties.VirtualNetworkConnections {
+		if connection.Name != nil && *connection.Name == name {
+			return &connection, i
+		}
+	}
+
+	return nil, -1
+}
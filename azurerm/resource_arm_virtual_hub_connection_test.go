@@ -0,0 +1,222 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualHubConnection_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHubConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualHubConnection_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHubConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualHubConnection_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_virtual_hub_connection"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualHubConnection_update(t *testing.T) {
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHubConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_internet_security", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualHubConnection_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_hub_to_remote_vnet_transit", "true"),
+					resource.TestCheckResourceAttr(resourceName, "enable_internet_security", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualHubConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		hubName := id.Path["virtualHubs"]
+		name := id.Path["hubVirtualNetworkConnections"]
+
+		client := testAccProvider.Meta().(*ArmClient).virtualHubsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		hub, err := client.Get(ctx, resourceGroup, hubName)
+		if err != nil {
+			if utils.ResponseWasNotFound(hub.Response) {
+				return fmt.Errorf("Bad: Virtual Hub %q (Resource Group: %q) does not exist", hubName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on virtualHubsClient: %+v", err)
+		}
+
+		if connection, _ := findArmVirtualHubConnectionByName(hub, name); connection == nil {
+			return fmt.Errorf("Bad: Connection %q does not exist in Virtual Hub %q (Resource Group: %q)", name, hubName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualHubConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).virtualHubsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_hub_connection" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		hubName := id.Path["virtualHubs"]
+		name := id.Path["hubVirtualNetworkConnections"]
+
+		hub, err := client.Get(ctx, resourceGroup, hubName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(hub.Response) {
+				return fmt.Errorf("Bad: Get on virtualHubsClient: %+v", err)
+			}
+			return nil
+		}
+
+		if connection, _ := findArmVirtualHubConnectionByName(hub, name); connection != nil {
+			return fmt.Errorf("Virtual Hub Connection still exists:\n%#v", connection.HubVirtualNetworkConnectionProperties)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualHubConnection_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualHubConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                      = "acctestvhubconn-%d"
+  virtual_hub_id            = "${azurerm_virtual_hub.test.id}"
+  remote_virtual_network_id = "${azurerm_virtual_network.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualHubConnection_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVirtualHubConnection_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_connection" "import" {
+  name                      = "${azurerm_virtual_hub_connection.test.name}"
+  virtual_hub_id            = "${azurerm_virtual_hub_connection.test.virtual_hub_id}"
+  remote_virtual_network_id = "${azurerm_virtual_hub_connection.test.remote_virtual_network_id}"
+}
+`, template)
+}
+
+func testAccAzureRMVirtualHubConnection_complete(rInt int, location string) string {
+	template := testAccAzureRMVirtualHubConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                             = "acctestvhubconn-%d"
+  virtual_hub_id                   = "${azurerm_virtual_hub.test.id}"
+  remote_virtual_network_id        = "${azurerm_virtual_network.test.id}"
+  allow_hub_to_remote_vnet_transit = true
+  enable_internet_security         = true
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualHubConnection_template(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["172.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, template, rInt)
+}
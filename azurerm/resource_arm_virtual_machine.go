@@ -29,6 +29,8 @@ func resourceArmVirtualMachine() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: azureRmVirtualMachineCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -250,6 +252,25 @@ func resourceArmVirtualMachine() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+
+						"diff_disk_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"option": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(compute.Local),
+										}, false),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -654,12 +675,22 @@ func resourceArmVirtualMachineCreate(d *schema.ResourceData, meta interface{}) e
 	azureRMLockByName(name, virtualMachineResourceName)
 	defer azureRMUnlockByName(name, virtualMachineResourceName)
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, vm)
-	if err != nil {
-		return err
+	update := func() error {
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, vm)
+		if err != nil {
+			return err
+		}
+
+		return future.WaitForCompletionRef(ctx, client.Client)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	// resizing the disks attached to a Virtual Machine requires that it's deallocated
+	if !d.IsNewResource() && virtualMachineDisksHaveBeenResized(d) {
+		err = updateVirtualMachineWhilstDeallocated(ctx, meta, resGroup, name, update)
+	} else {
+		err = update()
+	}
+	if err != nil {
 		return err
 	}
 
@@ -1255,6 +1286,8 @@ func flattenAzureRmVirtualMachineOsDisk(disk *compute.OSDisk, diskInfo *compute.
 		result["write_accelerator_enabled"] = *disk.WriteAcceleratorEnabled
 	}
 
+	result["diff_disk_settings"] = flattenAzureRmVirtualMachineDiffDiskSettings(disk.DiffDiskSettings)
+
 	flattenAzureRmVirtualMachineReviseDiskInfo(result, diskInfo)
 
 	return []interface{}{result}
@@ -1724,9 +1757,67 @@ func expandAzureRmVirtualMachineOsDisk(d *schema.ResourceData) (*compute.OSDisk,
 		osDisk.WriteAcceleratorEnabled = utils.Bool(v)
 	}
 
+	if v, ok := config["diff_disk_settings"].([]interface{}); ok {
+		osDisk.DiffDiskSettings = expandAzureRmVirtualMachineDiffDiskSettings(v)
+	}
+
 	return osDisk, nil
 }
 
+func expandAzureRmVirtualMachineDiffDiskSettings(input []interface{}) *compute.DiffDiskSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	settings := input[0].(map[string]interface{})
+	return &compute.DiffDiskSettings{
+		Option: compute.DiffDiskOptions(settings["option"].(string)),
+	}
+}
+
+func flattenAzureRmVirtualMachineDiffDiskSettings(input *compute.DiffDiskSettings) []interface{} {
+	if input == nil || input.Option == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"option": string(input.Option),
+		},
+	}
+}
+
+// validateAzureRmVirtualMachineEphemeralOsDisk ensures the OS Disk meets the requirements for an Ephemeral OS Disk,
+// which has to be a Managed Disk created from an Image and can only use `ReadOnly` caching.
+func validateAzureRmVirtualMachineEphemeralOsDisk(caching string, createOption string, managed bool) error {
+	if !managed {
+		return fmt.Errorf("Ephemeral OS Disks (`diff_disk_settings`) can only be used with Managed Disks")
+	}
+
+	if !strings.EqualFold(createOption, string(compute.DiskCreateOptionTypesFromImage)) {
+		return fmt.Errorf("Ephemeral OS Disks (`diff_disk_settings`) require `create_option` to be set to `FromImage`")
+	}
+
+	if !strings.EqualFold(caching, string(compute.CachingTypesReadOnly)) {
+		return fmt.Errorf("Ephemeral OS Disks (`diff_disk_settings`) require `caching` to be set to `ReadOnly`")
+	}
+
+	return nil
+}
+
+func azureRmVirtualMachineCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if _, ok := d.GetOk("storage_os_disk.0.diff_disk_settings.0"); ok {
+		caching := d.Get("storage_os_disk.0.caching").(string)
+		createOption := d.Get("storage_os_disk.0.create_option").(string)
+		managed := d.Get("storage_os_disk.0.vhd_uri").(string) == ""
+		if err := validateAzureRmVirtualMachineEphemeralOsDisk(caching, createOption, managed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func findStorageAccountResourceGroup(meta interface{}, storageAccountName string) (string, error) {
 	client := meta.(*ArmClient).resourcesClient
 	ctx := meta.(*ArmClient).StopContext
@@ -115,6 +115,33 @@ func TestAccAzureRMVirtualMachineDataDiskAttachment_updatingCaching(t *testing.T
 	})
 }
 
+func TestAccAzureRMVirtualMachineDataDiskAttachment_resizingManagedDisk(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_data_disk_attachment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDataDiskAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineDataDiskAttachment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr("azurerm_managed_disk.test", "disk_size_gb", "10"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineDataDiskAttachment_resizedManagedDisk(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr("azurerm_managed_disk.test", "disk_size_gb", "20"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMVirtualMachineDataDiskAttachment_updatingWriteAccelerator(t *testing.T) {
 	resourceName := "azurerm_virtual_machine_data_disk_attachment.test"
 	ri := acctest.RandInt()
@@ -281,6 +308,20 @@ resource "azurerm_virtual_machine_data_disk_attachment" "test" {
 `, template)
 }
 
+func testAccAzureRMVirtualMachineDataDiskAttachment_resizedManagedDisk(rInt int, location string) string {
+	template := testAccAzureRMVirtualMachineDataDiskAttachment_templateWithDiskSize(rInt, location, 20)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_data_disk_attachment" "test" {
+  managed_disk_id    = "${azurerm_managed_disk.test.id}"
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  lun                = "0"
+  caching            = "None"
+}
+`, template)
+}
+
 func testAccAzureRMVirtualMachineDataDiskAttachment_multipleDisks(rInt int, location string) string {
 	template := testAccAzureRMVirtualMachineDataDiskAttachment_template(rInt, location)
 	return fmt.Sprintf(`
@@ -424,6 +465,10 @@ resource "azurerm_virtual_machine_data_disk_attachment" "test" {
 }
 
 func testAccAzureRMVirtualMachineDataDiskAttachment_template(rInt int, location string) string {
+	return testAccAzureRMVirtualMachineDataDiskAttachment_templateWithDiskSize(rInt, location, 10)
+}
+
+func testAccAzureRMVirtualMachineDataDiskAttachment_templateWithDiskSize(rInt int, location string, diskSize int) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
   name     = "acctestRG-%d"
@@ -494,9 +539,9 @@ resource "azurerm_managed_disk" "test" {
   resource_group_name  = "${azurerm_resource_group.test.name}"
   storage_account_type = "Standard_LRS"
   create_option        = "Empty"
-  disk_size_gb         = 10
+  disk_size_gb         = %d
 }
-`, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt)
+`, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt, diskSize)
 }
 
 func testAccAzureRMVirtualMachineDataDiskAttachment_virtualMachineExtensionPrep(rInt int, location string) string {
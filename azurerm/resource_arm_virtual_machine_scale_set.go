@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -29,6 +31,10 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -137,6 +143,28 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				Default:  false,
 			},
 
+			"automatic_os_upgrade_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disable_automatic_rollback": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"roll_instances_when_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"rolling_upgrade_policy": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -576,6 +604,25 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+
+						"diff_disk_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"option": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(compute.Local),
+										}, false),
+									},
+								},
+							},
+						},
 					},
 				},
 				Set: resourceArmVirtualMachineScaleSetStorageProfileOsDiskHash,
@@ -800,6 +847,7 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 		UpgradePolicy: &compute.UpgradePolicy{
 			Mode:                 compute.UpgradeMode(upgradePolicy),
 			AutomaticOSUpgrade:   utils.Bool(automaticOsUpgrade),
+			AutoOSUpgradePolicy:  expandAzureRmVirtualMachineScaleSetAutomaticOSUpgradePolicy(d),
 			RollingUpgradePolicy: expandAzureRmRollingUpgradePolicy(d),
 		},
 		VirtualMachineProfile: &compute.VirtualMachineScaleSetVMProfile{
@@ -854,6 +902,18 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 		properties.Plan = plan
 	}
 
+	rollInstances := !d.IsNewResource() && d.Get("roll_instances_when_required").(bool) && virtualMachineScaleSetModelHasChanged(d)
+
+	// the start time of the latest Rolling Upgrade has to be retrieved before the model is updated, since the
+	// platform can start rolling out the new model as soon as it's been updated
+	var previousRollingUpgradeStartTime *date.Time
+	if rollInstances && strings.EqualFold(upgradePolicy, string(compute.Rolling)) {
+		previousRollingUpgradeStartTime, err = virtualMachineScaleSetLatestRollingUpgradeStartTime(ctx, meta, resGroup, name)
+		if err != nil {
+			return err
+		}
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, properties)
 	if err != nil {
 		return err
@@ -863,6 +923,13 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 		return err
 	}
 
+	if rollInstances {
+		startOSUpgrade := d.HasChange("storage_profile_image_reference") && virtualMachineScaleSetImageIsLatestPlatformImage(d)
+		if err := rollVirtualMachineScaleSetInstances(ctx, meta, resGroup, name, upgradePolicy, previousRollingUpgradeStartTime, startOSUpgrade, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		return err
@@ -918,6 +985,10 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 			d.Set("upgrade_policy_mode", upgradePolicy.Mode)
 			d.Set("automatic_os_upgrade", upgradePolicy.AutomaticOSUpgrade)
 
+			if err := d.Set("automatic_os_upgrade_policy", flattenAzureRmVirtualMachineScaleSetAutomaticOSUpgradePolicy(upgradePolicy.AutoOSUpgradePolicy)); err != nil {
+				return fmt.Errorf("[DEBUG] Error setting `automatic_os_upgrade_policy`: %#v", err)
+			}
+
 			if rollingUpgradePolicy := upgradePolicy.RollingUpgradePolicy; rollingUpgradePolicy != nil {
 				if err := d.Set("rolling_upgrade_policy", flattenAzureRmVirtualMachineScaleSetRollingUpgradePolicy(rollingUpgradePolicy)); err != nil {
 					return fmt.Errorf("[DEBUG] Error setting Virtual Machine Scale Set Rolling Upgrade Policy error: %#v", err)
@@ -1211,6 +1282,23 @@ func flattenAzureRmVirtualMachineScaleSetRollingUpgradePolicy(rollingUpgradePoli
 	return []interface{}{b}
 }
 
+func flattenAzureRmVirtualMachineScaleSetAutomaticOSUpgradePolicy(input *compute.AutoOSUpgradePolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	disableAutomaticRollback := false
+	if v := input.DisableAutoRollback; v != nil {
+		disableAutomaticRollback = *v
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"disable_automatic_rollback": disableAutomaticRollback,
+		},
+	}
+}
+
 func flattenAzureRmVirtualMachineScaleSetNetworkProfile(profile *compute.VirtualMachineScaleSetNetworkProfile) []map[string]interface{} {
 	networkConfigurations := profile.NetworkInterfaceConfigurations
 	result := make([]map[string]interface{}, 0, len(*networkConfigurations))
@@ -1374,6 +1462,7 @@ func flattenAzureRmVirtualMachineScaleSetStorageProfileOSDisk(profile *compute.V
 	result["caching"] = profile.Caching
 	result["create_option"] = profile.CreateOption
 	result["os_type"] = profile.OsType
+	result["diff_disk_settings"] = flattenAzureRmVirtualMachineDiffDiskSettings(profile.DiffDiskSettings)
 
 	return []interface{}{result}
 }
@@ -1597,6 +1686,16 @@ func expandAzureRmRollingUpgradePolicy(d *schema.ResourceData) *compute.RollingU
 	return nil
 }
 
+func expandAzureRmVirtualMachineScaleSetAutomaticOSUpgradePolicy(d *schema.ResourceData) *compute.AutoOSUpgradePolicy {
+	if config, ok := d.GetOk("automatic_os_upgrade_policy.0"); ok {
+		policy := config.(map[string]interface{})
+		return &compute.AutoOSUpgradePolicy{
+			DisableAutoRollback: utils.Bool(policy["disable_automatic_rollback"].(bool)),
+		}
+	}
+	return nil
+}
+
 func expandAzureRmVirtualMachineScaleSetNetworkProfile(d *schema.ResourceData) *compute.VirtualMachineScaleSetNetworkProfile {
 	scaleSetNetworkProfileConfigs := d.Get("network_profile").(*schema.Set).List()
 	networkProfileConfig := make([]compute.VirtualMachineScaleSetNetworkConfiguration, 0, len(scaleSetNetworkProfileConfigs))
@@ -1856,10 +1955,11 @@ func expandAzureRMVirtualMachineScaleSetsStorageProfileOsDisk(d *schema.Resource
 	}
 
 	osDisk := &compute.VirtualMachineScaleSetOSDisk{
-		Name:         &name,
-		Caching:      compute.CachingTypes(caching),
-		OsType:       compute.OperatingSystemTypes(osType),
-		CreateOption: compute.DiskCreateOptionTypes(createOption),
+		Name:             &name,
+		Caching:          compute.CachingTypes(caching),
+		OsType:           compute.OperatingSystemTypes(osType),
+		CreateOption:     compute.DiskCreateOptionTypes(createOption),
+		DiffDiskSettings: expandAzureRmVirtualMachineDiffDiskSettings(osDiskConfig["diff_disk_settings"].([]interface{})),
 	}
 
 	if image != "" {
@@ -2194,8 +2294,35 @@ func azureRmVirtualMachineScaleSetSuppressRollingUpgradePolicyDiff(k, _, new str
 	return false
 }
 
-// Make sure rolling_upgrade_policy is default value when upgrade_policy_mode is not Rolling.
+// Make sure rolling_upgrade_policy is default value when upgrade_policy_mode is not Rolling,
+// that automatic_os_upgrade_policy is only specified when automatic OS upgrades are enabled,
+// and that Ephemeral OS Disks are valid.
 func azureRmVirtualMachineScaleSetCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	// the Eviction Policy only applies to Low priority Scale Sets and isn't sent to Azure otherwise - existing configurations
+	// may set it regardless, so this is logged rather than rejected
+	if v := d.Get("eviction_policy").(string); v != "" && !strings.EqualFold(d.Get("priority").(string), string(compute.Low)) {
+		log.Printf("[WARN] `eviction_policy` is only used when `priority` is set to `Low` - ignoring %q", v)
+	}
+
+	if osDisks := d.Get("storage_profile_os_disk").(*schema.Set).List(); len(osDisks) > 0 && osDisks[0] != nil {
+		osDisk := osDisks[0].(map[string]interface{})
+		if settings, ok := osDisk["diff_disk_settings"].([]interface{}); ok && len(settings) > 0 {
+			managed := osDisk["vhd_containers"].(*schema.Set).Len() == 0
+			if err := validateAzureRmVirtualMachineEphemeralOsDisk(osDisk["caching"].(string), osDisk["create_option"].(string), managed); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !d.Get("automatic_os_upgrade").(bool) {
+		if policyRaw, ok := d.GetOk("automatic_os_upgrade_policy.0"); ok {
+			policy := policyRaw.(map[string]interface{})
+			if policy["disable_automatic_rollback"].(bool) {
+				return fmt.Errorf("`automatic_os_upgrade_policy` can only be configured when `automatic_os_upgrade` is enabled")
+			}
+		}
+	}
+
 	mode := d.Get("upgrade_policy_mode").(string)
 	if strings.ToLower(mode) != "rolling" {
 		if policyRaw, ok := d.GetOk("rolling_upgrade_policy.0"); ok {
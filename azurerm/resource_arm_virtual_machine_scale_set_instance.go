@@ -0,0 +1,215 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVirtualMachineScaleSetInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineScaleSetInstanceCreateUpdate,
+		Read:   resourceArmVirtualMachineScaleSetInstanceRead,
+		Update: resourceArmVirtualMachineScaleSetInstanceCreateUpdate,
+		Delete: resourceArmVirtualMachineScaleSetInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"virtual_machine_scale_set_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"power_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "running",
+				ValidateFunc: validation.StringInSlice([]string{
+					"running",
+					"stopped",
+					"deallocated",
+				}, false),
+			},
+
+			"reimage_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"virtual_machine_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"latest_model_applied": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineScaleSetInstanceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetVMsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup := d.Get("resource_group_name").(string)
+	scaleSetName := d.Get("virtual_machine_scale_set_name").(string)
+	instanceId := d.Get("instance_id").(string)
+
+	log.Printf("[INFO] preparing arguments for Virtual Machine Scale Set Instance %q (Scale Set %q / Resource Group %q)", instanceId, scaleSetName, resGroup)
+
+	// the instance itself is created by the Scale Set - as such we're only managing operations on an existing instance
+	read, err := client.Get(ctx, resGroup, scaleSetName, instanceId)
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			return fmt.Errorf("Instance %q was not found in Virtual Machine Scale Set %q (Resource Group %q)", instanceId, scaleSetName, resGroup)
+		}
+		return fmt.Errorf("Error retrieving Instance %q of Virtual Machine Scale Set %q (Resource Group %q): %+v", instanceId, scaleSetName, resGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Instance %q of Virtual Machine Scale Set %q (Resource Group %q)", instanceId, scaleSetName, resGroup)
+	}
+
+	if !d.IsNewResource() && d.HasChange("reimage_trigger") {
+		log.Printf("[DEBUG] Reimaging Instance %q of Virtual Machine Scale Set %q (Resource Group %q)..", instanceId, scaleSetName, resGroup)
+		future, err := client.Reimage(ctx, resGroup, scaleSetName, instanceId)
+		if err != nil {
+			return fmt.Errorf("Error reimaging Instance %q of Virtual Machine Scale Set %q (Resource Group %q): %+v", instanceId, scaleSetName, resGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the reimage of Instance %q of Virtual Machine Scale Set %q (Resource Group %q): %+v", instanceId, scaleSetName, resGroup, err)
+		}
+	}
+
+	powerState, err := virtualMachineScaleSetInstancePowerState(ctx, client, resGroup, scaleSetName, instanceId)
+	if err != nil {
+		return err
+	}
+
+	desiredPowerState := d.Get("power_state").(string)
+
+	// an Instance has to be running before it can be stopped, since stopping a deallocated Instance isn't supported
+	if desiredPowerState == "running" || (desiredPowerState == "stopped" && powerState == "deallocated") {
+		if powerState != "running" && powerState != "starting" {
+			log.Printf("[DEBUG] Starting Instance %q of Virtual Machine Scale Set %q (Resource Group %q)..", instanceId, scaleSetName, resGroup)
+			future, err := client.Start(ctx, resGroup, scaleSetName, instanceId)
+			if err != nil {
+				return fmt.Errorf("Error starting Instance %q of Virtual Machine Scale Set %q (Resource Group %q): %+v", instanceId, scaleSetName, resGroup, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("Error waiting for Instance %q of Virtual Machine Scale Set %q (Resource Group %q) to start: %+v", instanceId, scaleSetName, resGroup, err)
+			}
+		}
+	}
+
+	if desiredPowerState == "stopped" && powerState != "stopped" {
+		log.Printf("[DEBUG] Stopping Instance %q of Virtual Machine Scale Set %q (Resource Group %q)..", instanceId, scaleSetName, resGroup)
+		future, err := client.PowerOff(ctx, resGroup, scaleSetName, instanceId)
+		if err != nil {
+			return fmt.Errorf("Error stopping Instance %q of Virtual Machine Scale Set %q (Resource Group %q): %+v", instanceId, scaleSetName, resGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Instance %q of Virtual Machine Scale Set %q (Resource Group %q) to stop: %+v", instanceId, scaleSetName, resGroup, err)
+		}
+	}
+
+	if desiredPowerState == "deallocated" && powerState != "deallocated" {
+		log.Printf("[DEBUG] Deallocating Instance %q of Virtual Machine Scale Set %q (Resource Group %q)..", instanceId, scaleSetName, resGroup)
+		future, err := client.Deallocate(ctx, resGroup, scaleSetName, instanceId)
+		if err != nil {
+			return fmt.Errorf("Error deallocating Instance %q of Virtual Machine Scale Set %q (Resource Group %q): %+v", instanceId, scaleSetName, resGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the deallocation of Instance %q of Virtual Machine Scale Set %q (Resource Group %q): %+v", instanceId, scaleSetName, resGroup, err)
+		}
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualMachineScaleSetInstanceRead(d, meta)
+}
+
+func resourceArmVirtualMachineScaleSetInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetVMsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	scaleSetName := id.Path["virtualMachineScaleSets"]
+	instanceId := id.Path["virtualMachines"]
+
+	resp, err := client.Get(ctx, resGroup, scaleSetName, instanceId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Instance %q of Virtual Machine Scale Set %q was not found in Resource Group %q - removing from state!", instanceId, scaleSetName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Instance %q of Virtual Machine Scale Set %q (Resource Group %q): %+v", instanceId, scaleSetName, resGroup, err)
+	}
+
+	powerState, err := virtualMachineScaleSetInstancePowerState(ctx, client, resGroup, scaleSetName, instanceId)
+	if err != nil {
+		return err
+	}
+
+	d.Set("resource_group_name", resGroup)
+	d.Set("virtual_machine_scale_set_name", scaleSetName)
+	d.Set("instance_id", instanceId)
+	d.Set("name", resp.Name)
+	d.Set("power_state", powerState)
+
+	if props := resp.VirtualMachineScaleSetVMProperties; props != nil {
+		d.Set("virtual_machine_id", props.VMID)
+		d.Set("latest_model_applied", props.LatestModelApplied)
+	}
+
+	return nil
+}
+
+func resourceArmVirtualMachineScaleSetInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	// the lifecycle of the instance is managed by the Scale Set - so we intentionally leave the instance as-is
+	log.Printf("[DEBUG] Removing Virtual Machine Scale Set Instance %q from the state - the instance itself is left untouched", d.Id())
+	return nil
+}
+
+func virtualMachineScaleSetInstancePowerState(ctx context.Context, client compute.VirtualMachineScaleSetVMsClient, resourceGroup string, scaleSetName string, instanceId string) (string, error) {
+	instanceView, err := client.GetInstanceView(ctx, resourceGroup, scaleSetName, instanceId)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving Instance View for Instance %q of Virtual Machine Scale Set %q (Resource Group %q): %+v", instanceId, scaleSetName, resourceGroup, err)
+	}
+
+	return virtualMachinePowerStateFromStatuses(instanceView.Statuses), nil
+}
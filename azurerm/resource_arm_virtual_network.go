@@ -10,6 +10,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -48,6 +49,26 @@ func resourceArmVirtualNetwork() *schema.Resource {
 				},
 			},
 
+			"ddos_protection_plan": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"enable": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"dns_servers": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -127,9 +148,13 @@ func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	// the Network Security Groups are locked before the Virtual Network, matching the order used by the Subnet resources
 	azureRMLockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 	defer azureRMUnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
+	azureRMLockByName(name, virtualNetworkResourceName)
+	defer azureRMUnlockByName(name, virtualNetworkResourceName)
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, vnet)
 	if err != nil {
 		return fmt.Errorf("Error Creating/Updating Virtual Network %q (Resource Group %q): %+v", name, resGroup, err)
@@ -183,6 +208,10 @@ func resourceArmVirtualNetworkRead(d *schema.ResourceData, meta interface{}) err
 			d.Set("address_space", space.AddressPrefixes)
 		}
 
+		if err := d.Set("ddos_protection_plan", flattenVirtualNetworkDDoSProtectionPlan(props)); err != nil {
+			return fmt.Errorf("Error setting `ddos_protection_plan`: %+v", err)
+		}
+
 		subnets := flattenVirtualNetworkSubnets(props.Subnets)
 		if err := d.Set("subnet", subnets); err != nil {
 			return fmt.Errorf("Error setting `subnets`: %+v", err)
@@ -281,8 +310,36 @@ func expandVirtualNetworkProperties(ctx context.Context, d *schema.ResourceData,
 		},
 		Subnets: &subnets,
 	}
+
+	if v, ok := d.GetOk("ddos_protection_plan"); ok {
+		ddosPlans := v.([]interface{})
+		ddosPlan := ddosPlans[0].(map[string]interface{})
+
+		properties.DdosProtectionPlan = &network.SubResource{
+			ID: utils.String(ddosPlan["id"].(string)),
+		}
+		properties.EnableDdosProtection = utils.Bool(ddosPlan["enable"].(bool))
+	}
+
 	return properties, nil
 }
+
+func flattenVirtualNetworkDDoSProtectionPlan(input *network.VirtualNetworkPropertiesFormat) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	if input.DdosProtectionPlan == nil || input.DdosProtectionPlan.ID == nil || input.EnableDdosProtection == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"id":     *input.DdosProtectionPlan.ID,
+			"enable": *input.EnableDdosProtection,
+		},
+	}
+}
 func flattenVirtualNetworkSubnets(input *[]network.Subnet) *schema.Set {
 	results := &schema.Set{
 		F: resourceAzureSubnetHash,
@@ -88,6 +88,12 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 				Computed: true,
 			},
 
+			"express_route_gateway_bypass": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"use_policy_based_traffic_selectors": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -323,6 +329,10 @@ func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta
 		d.Set("enable_bgp", conn.EnableBgp)
 	}
 
+	if conn.ExpressRouteGatewayBypass != nil {
+		d.Set("express_route_gateway_bypass", conn.ExpressRouteGatewayBypass)
+	}
+
 	if conn.UsePolicyBasedTrafficSelectors != nil {
 		d.Set("use_policy_based_traffic_selectors", conn.UsePolicyBasedTrafficSelectors)
 	}
@@ -407,6 +417,8 @@ func getArmVirtualNetworkGatewayConnectionProperties(d *schema.ResourceData) (*n
 		}
 	}
 
+	props.ExpressRouteGatewayBypass = utils.Bool(d.Get("express_route_gateway_bypass").(bool))
+
 	if v, ok := d.GetOk("peer_virtual_network_gateway_id"); ok {
 		peerVirtualNetworkGatewayId := v.(string)
 		_, name, err := resourceGroupAndVirtualNetworkGatewayFromId(peerVirtualNetworkGatewayId)
@@ -458,6 +470,10 @@ func getArmVirtualNetworkGatewayConnectionProperties(d *schema.ResourceData) (*n
 		}
 	}
 
+	if props.ExpressRouteGatewayBypass != nil && *props.ExpressRouteGatewayBypass && props.ConnectionType != network.ExpressRoute {
+		return nil, fmt.Errorf("`express_route_gateway_bypass` can only be enabled when `type` is set to `ExpressRoute`")
+	}
+
 	if props.ConnectionType == network.IPsec {
 		if props.LocalNetworkGateway2 == nil || props.LocalNetworkGateway2.ID == nil {
 			return nil, fmt.Errorf("`local_network_gateway_id` and `shared_key` must be specified when `type` is set to `IPsec")
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -81,6 +82,46 @@ func TestAccAzureRMVirtualNetworkGatewayConnection_ipsecpolicy(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMVirtualNetworkGatewayConnection_expressRoute(t *testing.T) {
+	// an ExpressRoute Circuit has to be provisioned by a Service Provider before it can be connected to
+	circuitIdEnvVariable := "ARM_TEST_EXPRESS_ROUTE_CIRCUIT_ID"
+	circuitId := os.Getenv(circuitIdEnvVariable)
+	if circuitId == "" {
+		t.Skipf("Skipping as %q is not specified", circuitIdEnvVariable)
+	}
+
+	resourceName := "azurerm_virtual_network_gateway_connection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualNetworkGatewayConnection_expressRoute(ri, location, circuitId, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkGatewayConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "express_route_gateway_bypass", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualNetworkGatewayConnection_expressRoute(ri, location, circuitId, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkGatewayConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "express_route_gateway_bypass", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMVirtualNetworkGatewayConnection_updatingSharedKey(t *testing.T) {
 	firstResourceName := "azurerm_virtual_network_gateway_connection.test_1"
 	secondResourceName := "azurerm_virtual_network_gateway_connection.test_2"
@@ -244,6 +285,67 @@ resource "azurerm_virtual_network_gateway_connection" "test" {
 `, rInt, location)
 }
 
+func testAccAzureRMVirtualNetworkGatewayConnection_expressRoute(rInt int, location string, circuitId string, gatewayBypass bool) string {
+	return fmt.Sprintf(`
+variable "random" {
+  default = "%d"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-${var.random}"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-${var.random}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctest-${var.random}"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "Dynamic"
+}
+
+resource "azurerm_virtual_network_gateway" "test" {
+  name                = "acctest-${var.random}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  type = "ExpressRoute"
+  sku  = "UltraPerformance"
+
+  ip_configuration {
+    name                          = "vnetGatewayConfig"
+    public_ip_address_id          = "${azurerm_public_ip.test.id}"
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+  }
+}
+
+resource "azurerm_virtual_network_gateway_connection" "test" {
+  name                = "acctest-${var.random}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  type                         = "ExpressRoute"
+  virtual_network_gateway_id   = "${azurerm_virtual_network_gateway.test.id}"
+  express_route_circuit_id     = "%s"
+  express_route_gateway_bypass = %t
+}
+`, rInt, location, circuitId, gatewayBypass)
+}
+
 func testAccAzureRMVirtualNetworkGatewayConnection_vnettovnet(rInt, rInt2 int, sharedKey, location, altLocation string) string {
 	return fmt.Sprintf(`
 variable "random1" {
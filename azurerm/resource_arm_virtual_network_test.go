@@ -183,6 +183,51 @@ func TestAccAzureRMVirtualNetwork_bug373(t *testing.T) {
 	})
 }
 
+func testAccAzureRMVirtualNetwork_ddosProtectionPlan(t *testing.T) {
+	resourceName := "azurerm_virtual_network.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualNetwork_ddosProtectionPlanConfig(ri, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ddos_protection_plan.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ddos_protection_plan.0.enable", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "ddos_protection_plan.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMVirtualNetwork_ddosProtectionPlanConfig(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ddos_protection_plan.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ddos_protection_plan.0.enable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualNetwork_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ddos_protection_plan.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMVirtualNetworkExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -292,6 +337,38 @@ resource "azurerm_virtual_network" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMVirtualNetwork_ddosProtectionPlanConfig(rInt int, location string, enable bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_ddos_protection_plan" "test" {
+  name                = "acctestddospplan-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ddos_protection_plan {
+    id     = "${azurerm_ddos_protection_plan.test.id}"
+    enable = %t
+  }
+
+  subnet {
+    name           = "subnet1"
+    address_prefix = "10.0.1.0/24"
+  }
+}
+`, rInt, location, rInt, rInt, enable)
+}
+
 func testAccAzureRMVirtualNetwork_withTags(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -11,6 +11,14 @@ func ResponseWasNotFound(resp autorest.Response) bool {
 	return responseWasStatusCode(resp, http.StatusNotFound)
 }
 
+func ResponseWasBadRequest(resp autorest.Response) bool {
+	return responseWasStatusCode(resp, http.StatusBadRequest)
+}
+
+func ResponseWasForbidden(resp autorest.Response) bool {
+	return responseWasStatusCode(resp, http.StatusForbidden)
+}
+
 func ResponseErrorIsRetryable(err error) bool {
 	if arerr, ok := err.(autorest.DetailedError); ok {
 		err = arerr.Original
@@ -0,0 +1,132 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// updateVirtualMachineWhilstDeallocated runs the specified update whilst the Virtual Machine is deallocated, which is
+// required for operations such as resizing a Disk attached to the Virtual Machine. Virtual Machines which were running
+// prior to the update are started again once it's completed, regardless of whether the update succeeded.
+//
+// NOTE: callers are expected to hold the lock on the Virtual Machine for the duration of this call.
+func updateVirtualMachineWhilstDeallocated(ctx context.Context, meta interface{}, resourceGroup string, name string, update func() error) error {
+	client := meta.(*ArmClient).vmClient
+
+	powerState, err := virtualMachinePowerState(ctx, client, resourceGroup, name)
+	if err != nil {
+		return err
+	}
+
+	if powerState != "deallocated" {
+		log.Printf("[DEBUG] Deallocating Virtual Machine %q (Resource Group %q) prior to updating..", name, resourceGroup)
+		future, err := client.Deallocate(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error deallocating Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the deallocation of Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	updateErr := update()
+
+	if powerState == "running" || powerState == "starting" {
+		log.Printf("[DEBUG] Starting Virtual Machine %q (Resource Group %q) after updating..", name, resourceGroup)
+		if err := startVirtualMachine(ctx, client, resourceGroup, name); err != nil {
+			// the original error is more useful, so ensure it isn't lost if the Virtual Machine fails to start
+			if updateErr != nil {
+				return fmt.Errorf("%+v; additionally failed to start the Virtual Machine: %+v", updateErr, err)
+			}
+
+			return err
+		}
+	}
+
+	return updateErr
+}
+
+func startVirtualMachine(ctx context.Context, client compute.VirtualMachinesClient, resourceGroup string, name string) error {
+	future, err := client.Start(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error starting Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to start: %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func virtualMachinePowerState(ctx context.Context, client compute.VirtualMachinesClient, resourceGroup string, name string) (string, error) {
+	instanceView, err := client.InstanceView(ctx, resourceGroup, name)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving Instance View for Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return virtualMachinePowerStateFromStatuses(instanceView.Statuses), nil
+}
+
+// virtualMachinePowerStateFromStatuses returns the lower-cased Power State (e.g. `running` or `deallocated`)
+// from the Statuses within an Instance View, or an empty string if no Power State is present
+func virtualMachinePowerStateFromStatuses(statuses *[]compute.InstanceViewStatus) string {
+	if statuses == nil {
+		return ""
+	}
+
+	for _, status := range *statuses {
+		if status.Code == nil {
+			continue
+		}
+
+		code := strings.ToLower(*status.Code)
+		if strings.HasPrefix(code, "powerstate/") {
+			return strings.TrimPrefix(code, "powerstate/")
+		}
+	}
+
+	return ""
+}
+
+// virtualMachineDisksHaveBeenResized determines if either the OS Disk or any of the existing Data Disks
+// have been resized - which requires the Virtual Machine to be deallocated.
+func virtualMachineDisksHaveBeenResized(d *schema.ResourceData) bool {
+	if d.HasChange("storage_os_disk.0.disk_size_gb") {
+		old, new := d.GetChange("storage_os_disk.0.disk_size_gb")
+		if old.(int) != 0 && new.(int) != 0 {
+			return true
+		}
+	}
+
+	if !d.HasChange("storage_data_disk") {
+		return false
+	}
+
+	old, new := d.GetChange("storage_data_disk")
+	existingSizes := make(map[string]int)
+	for _, v := range old.([]interface{}) {
+		disk := v.(map[string]interface{})
+		existingSizes[disk["name"].(string)] = disk["disk_size_gb"].(int)
+	}
+
+	for _, v := range new.([]interface{}) {
+		disk := v.(map[string]interface{})
+		existingSize, exists := existingSizes[disk["name"].(string)]
+		if !exists || existingSize == 0 {
+			continue
+		}
+
+		if size := disk["disk_size_gb"].(int); size != 0 && size != existingSize {
+			return true
+		}
+	}
+
+	return false
+}
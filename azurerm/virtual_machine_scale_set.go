@@ -0,0 +1,246 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// these fields form the Virtual Machine model used by each instance within the Scale Set - changing
+// any of them means the existing instances need to be upgraded to the latest model
+var virtualMachineScaleSetModelFields = []string{
+	"boot_diagnostics",
+	"extension",
+	"health_probe_id",
+	"license_type",
+	"network_profile",
+	"os_profile",
+	"os_profile_linux_config",
+	"os_profile_secrets",
+	"os_profile_windows_config",
+	"storage_profile_data_disk",
+	"storage_profile_image_reference",
+	"storage_profile_os_disk",
+}
+
+func virtualMachineScaleSetModelHasChanged(d *schema.ResourceData) bool {
+	for _, field := range virtualMachineScaleSetModelFields {
+		if d.HasChange(field) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// virtualMachineScaleSetImageIsLatestPlatformImage returns whether the Scale Set uses a Platform Image pinned to the
+// `latest` version - the only kind of image an OS Upgrade applies to
+func virtualMachineScaleSetImageIsLatestPlatformImage(d *schema.ResourceData) bool {
+	for _, raw := range d.Get("storage_profile_image_reference").(*schema.Set).List() {
+		image := raw.(map[string]interface{})
+		if image["id"].(string) != "" {
+			return false
+		}
+
+		return strings.EqualFold(image["version"].(string), "latest")
+	}
+
+	return false
+}
+
+// virtualMachineScaleSetLatestRollingUpgradeStartTime returns the time the most recent Rolling Upgrade started,
+// which is nil when no Rolling Upgrade has been run against this Scale Set
+func virtualMachineScaleSetLatestRollingUpgradeStartTime(ctx context.Context, meta interface{}, resourceGroup string, name string) (*date.Time, error) {
+	client := meta.(*ArmClient).vmScaleSetRollingUpgradesClient
+
+	latest, err := client.GetLatest(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(latest.Response) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("Error retrieving the latest Rolling Upgrade for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return virtualMachineScaleSetRollingUpgradeStartTime(latest), nil
+}
+
+// rollVirtualMachineScaleSetInstances rolls the latest Scale Set model out to the existing instances, waiting
+// until the rollout has either completed or failed. Scale Sets using the `Automatic` upgrade mode are updated
+// by the platform as soon as the model changes, so there's nothing to do for these.
+//
+// `previousUpgradeStartTime` is the start time of the latest Rolling Upgrade prior to the model being updated,
+// which allows the Rolling Upgrade for this change to be told apart from the ones which came before it.
+func rollVirtualMachineScaleSetInstances(ctx context.Context, meta interface{}, resourceGroup string, name string, mode string, previousUpgradeStartTime *date.Time, startOSUpgrade bool, timeout time.Duration) error {
+	if strings.EqualFold(mode, string(compute.Manual)) {
+		return updateVirtualMachineScaleSetInstancesToLatestModel(ctx, meta, resourceGroup, name)
+	}
+
+	if strings.EqualFold(mode, string(compute.Rolling)) {
+		return waitForVirtualMachineScaleSetRollingUpgrade(ctx, meta, resourceGroup, name, previousUpgradeStartTime, startOSUpgrade, timeout)
+	}
+
+	return nil
+}
+
+func updateVirtualMachineScaleSetInstancesToLatestModel(ctx context.Context, meta interface{}, resourceGroup string, name string) error {
+	client := meta.(*ArmClient).vmScaleSetClient
+
+	instanceIds, err := virtualMachineScaleSetInstancesNotRunningLatestModel(ctx, meta, resourceGroup, name)
+	if err != nil {
+		return err
+	}
+
+	if len(instanceIds) == 0 {
+		log.Printf("[DEBUG] All instances of Virtual Machine Scale Set %q (Resource Group %q) are running the latest model", name, resourceGroup)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Upgrading %d instances of Virtual Machine Scale Set %q (Resource Group %q) to the latest model..", len(instanceIds), name, resourceGroup)
+	input := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+		InstanceIds: &instanceIds,
+	}
+	future, err := client.UpdateInstances(ctx, resourceGroup, name, input)
+	if err != nil {
+		return fmt.Errorf("Error upgrading instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the upgrade of instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func virtualMachineScaleSetInstancesNotRunningLatestModel(ctx context.Context, meta interface{}, resourceGroup string, name string) ([]string, error) {
+	vmsClient := meta.(*ArmClient).vmScaleSetVMsClient
+
+	instances, err := vmsClient.ListComplete(ctx, resourceGroup, name, "", "", "")
+	if err != nil {
+		return nil, fmt.Errorf("Error listing instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	instanceIds := make([]string, 0)
+	for instances.NotDone() {
+		instance := instances.Value()
+		if instance.InstanceID != nil {
+			latestModelApplied := false
+			if props := instance.VirtualMachineScaleSetVMProperties; props != nil && props.LatestModelApplied != nil {
+				latestModelApplied = *props.LatestModelApplied
+			}
+
+			if !latestModelApplied {
+				instanceIds = append(instanceIds, *instance.InstanceID)
+			}
+		}
+
+		if err := instances.Next(); err != nil {
+			return nil, fmt.Errorf("Error listing instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return instanceIds, nil
+}
+
+func waitForVirtualMachineScaleSetRollingUpgrade(ctx context.Context, meta interface{}, resourceGroup string, name string, previousUpgradeStartTime *date.Time, startOSUpgrade bool, timeout time.Duration) error {
+	client := meta.(*ArmClient).vmScaleSetRollingUpgradesClient
+
+	// changes to the model are rolled out by the platform when using the `Rolling` upgrade mode - however moving
+	// to a newer version of a Platform Image pinned to `latest` is only done by an OS Upgrade
+	if startOSUpgrade {
+		log.Printf("[DEBUG] Starting an OS Upgrade for Virtual Machine Scale Set %q (Resource Group %q)..", name, resourceGroup)
+		future, err := client.StartOSUpgrade(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error starting OS Upgrade for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for OS Upgrade of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Waiting for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) to complete..", name, resourceGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"Waiting",
+			string(compute.RollingUpgradeStatusCodeRollingForward),
+		},
+		Target:  []string{string(compute.RollingUpgradeStatusCodeCompleted)},
+		Refresh: virtualMachineScaleSetRollingUpgradeStateRefreshFunc(ctx, meta, resourceGroup, name, previousUpgradeStartTime),
+		Timeout: timeout,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) to complete: %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func virtualMachineScaleSetRollingUpgradeStateRefreshFunc(ctx context.Context, meta interface{}, resourceGroup string, name string, previousUpgradeStartTime *date.Time) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		client := meta.(*ArmClient).vmScaleSetRollingUpgradesClient
+
+		resp, err := client.GetLatest(ctx, resourceGroup, name)
+		if err != nil && !utils.ResponseWasNotFound(resp.Response) {
+			return nil, "", fmt.Errorf("Error retrieving the latest Rolling Upgrade for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		status := virtualMachineScaleSetRollingUpgradeStatus(resp)
+		startTime := virtualMachineScaleSetRollingUpgradeStartTime(resp)
+		isNewUpgrade := startTime != nil && (previousUpgradeStartTime == nil || startTime.After(previousUpgradeStartTime.Time))
+
+		if !isNewUpgrade || status == "" {
+			// the Rolling Upgrade for this change hasn't started yet - which is expected when every instance
+			// is already running the latest model (for example when the Scale Set has no instances)
+			instanceIds, err := virtualMachineScaleSetInstancesNotRunningLatestModel(ctx, meta, resourceGroup, name)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if len(instanceIds) == 0 {
+				return resp, string(compute.RollingUpgradeStatusCodeCompleted), nil
+			}
+
+			return resp, "Waiting", nil
+		}
+
+		if status == string(compute.RollingUpgradeStatusCodeCancelled) || status == string(compute.RollingUpgradeStatusCodeFaulted) {
+			message := "no further details were returned"
+			if props := resp.RollingUpgradeStatusInfoProperties; props != nil && props.Error != nil && props.Error.Message != nil {
+				message = *props.Error.Message
+			}
+
+			return resp, status, fmt.Errorf("Rolling Upgrade finished with the status %q: %s", status, message)
+		}
+
+		return resp, status, nil
+	}
+}
+
+func virtualMachineScaleSetRollingUpgradeStatus(input compute.RollingUpgradeStatusInfo) string {
+	if props := input.RollingUpgradeStatusInfoProperties; props != nil {
+		if status := props.RunningStatus; status != nil {
+			return string(status.Code)
+		}
+	}
+
+	return ""
+}
+
+func virtualMachineScaleSetRollingUpgradeStartTime(input compute.RollingUpgradeStatusInfo) *date.Time {
+	if props := input.RollingUpgradeStatusInfoProperties; props != nil {
+		if status := props.RunningStatus; status != nil {
+			return status.StartTime
+		}
+	}
+
+	return nil
+}